@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/smartcontractkit/chainlink/store"
 	"github.com/smartcontractkit/chainlink/store/assets"
 	"github.com/smartcontractkit/chainlink/store/models"
@@ -46,6 +47,21 @@ type BaseAdapter interface {
 	Perform(models.RunResult, *store.Store) models.RunResult
 }
 
+// Simulator is implemented by adapters whose side effects must not happen
+// when simulating a job, returning what they would have done instead.
+type Simulator interface {
+	Simulate(models.RunResult, *store.Store, SimulationOptions) models.RunResult
+}
+
+// SimulationOptions configure how adapters simulate their side effects.
+type SimulationOptions struct {
+	// EthCall executes the transactions that would have been sent with
+	// eth_call against the latest block.
+	EthCall bool
+	// From is the address the simulated transactions are sent from.
+	From common.Address
+}
+
 // PipelineAdapter wraps a BaseAdapter with requirements for execution in the pipeline.
 type PipelineAdapter struct {
 	BaseAdapter
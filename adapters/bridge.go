@@ -51,6 +51,9 @@ func (ba *Bridge) handleNewRun(input models.RunResult, bridgeResponseURL *url.UR
 	responseURL := bridgeResponseURL
 	if *responseURL != *zeroURL {
 		responseURL.Path += fmt.Sprintf("/v2/runs/%s", input.JobRunID)
+		if input.TaskRunID != "" {
+			responseURL.Path += fmt.Sprintf("/task_runs/%s", input.TaskRunID)
+		}
 	}
 	body, err := ba.postToExternalAdapter(input, responseURL)
 	if err != nil {
@@ -136,6 +136,7 @@ func TestBridge_Perform_responseURL(t *testing.T) {
 	t.Parallel()
 	cases := []struct {
 		name          string
+		taskRunID     string
 		configuredURL models.WebURL
 		want          string
 	}{
@@ -149,6 +150,12 @@ func TestBridge_Perform_responseURL(t *testing.T) {
 			configuredURL: cltest.WebURL(""),
 			want:          `{"id":"1234","data":{"value":"lot 49"}}`,
 		},
+		{
+			name:          "task run",
+			taskRunID:     "5678",
+			configuredURL: cltest.WebURL("https://chain.link"),
+			want:          `{"id":"1234","data":{"value":"lot 49"},"responseURL":"https://chain.link/v2/runs/1234/task_runs/5678"}`,
+		},
 	}
 
 	for _, test := range cases {
@@ -163,6 +170,8 @@ func TestBridge_Perform_responseURL(t *testing.T) {
 				})
 			defer ensureCalled()
 
+			input := input
+			input.TaskRunID = test.taskRunID
 			eb := &adapters.Bridge{BridgeType: cltest.NewBridgeType("auctionBidding", mock.URL)}
 			eb.Perform(input, store)
 		})
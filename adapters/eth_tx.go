@@ -28,6 +28,7 @@ type EthTx struct {
 	DataFormat       string                  `json:"format"`
 	GasPrice         *big.Int                `json:"gasPrice"`
 	GasLimit         uint64                  `json:"gasLimit"`
+	MaxGasLimit      uint64                  `json:"maxGasLimit"`
 }
 
 // Perform creates the run result for the transaction if the existing run result
@@ -63,22 +64,48 @@ func getTxData(e *EthTx, input models.RunResult) ([]byte, error) {
 	return utils.ConcatBytes(payloadOffset, output)
 }
 
+// Simulate returns the calldata of the transaction the adapter would send,
+// without sending it. The transaction is executed with eth_call when the
+// options ask for it, adding the call's return data or error to the result.
+func (etx *EthTx) Simulate(input models.RunResult, store *store.Store, opts SimulationOptions) models.RunResult {
+	data, err := getTxCalldata(etx, input)
+	if err != nil {
+		return input.WithError(err)
+	}
+
+	output := input.Add("to", etx.Address.Hex())
+	output = output.WithValue(hexutil.Encode(data))
+	if !opts.EthCall {
+		return output
+	}
+
+	result, err := store.TxManager.CallContract(opts.From, etx.Address, data)
+	if err != nil {
+		return output.Add("ethCallError", err.Error())
+	}
+	return output.Add("ethCallResult", result.String())
+}
+
+// getTxCalldata returns the calldata of the transaction sent by the adapter.
+func getTxCalldata(e *EthTx, input models.RunResult) ([]byte, error) {
+	value, err := getTxData(e, input)
+	if err != nil {
+		return nil, err
+	}
+	return utils.ConcatBytes(e.FunctionSelector.Bytes(), e.DataPrefix, value)
+}
+
 func createTxRunResult(
 	e *EthTx,
 	input models.RunResult,
 	store *store.Store,
 ) models.RunResult {
-	value, err := getTxData(e, input)
+	data, err := getTxCalldata(e, input)
 	if err != nil {
 		return input.WithError(err)
 	}
 
-	data, err := utils.ConcatBytes(e.FunctionSelector.Bytes(), e.DataPrefix, value)
-	if err != nil {
-		return input.WithError(err)
-	}
-
-	tx, err := store.TxManager.CreateTxWithGas(e.Address, data, e.GasPrice, e.GasLimit)
+	tx, err := store.TxManager.CreateTxWithGas(e.Address, data, e.GasPrice, e.GasLimit, e.MaxGasLimit)
 	if err != nil {
 		return input.WithError(err)
 	}
@@ -99,6 +126,13 @@ func ensureTxRunResult(input models.RunResult, str *store.Store) models.RunResul
 	}
 
 	receipt, err := str.TxManager.BumpGasUntilSafe(hash)
+	if receipt != nil && receipt.Reverted() {
+		return addReceiptToResult(receipt, input).WithError(err)
+	} else if err == store.ErrTxCancelled {
+		return addReceiptToResult(receipt, input).MarkCancelled(err)
+	} else if err == store.ErrTxReplaced {
+		return input.WithError(err)
+	}
 	if err != nil {
 		logger.Error("EthTx Adapter Perform Resuming: ", err)
 	}
@@ -3,6 +3,7 @@ package adapters_test
 import (
 	"encoding/json"
 	"math/big"
+	"strings"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -461,7 +462,7 @@ func TestEthTxAdapter_DeserializationBytesFormat(t *testing.T) {
 			"0000000000000000000000000000000000000000000000000000000000000020"+
 			"000000000000000000000000000000000000000000000000000000000000000b"+
 			"68656c6c6f20776f726c64000000000000000000000000000000000000000000"),
-		gomock.Any(), gomock.Any()).Return(&models.Tx{}, nil)
+		gomock.Any(), gomock.Any(), gomock.Any()).Return(&models.Tx{}, nil)
 	txmMock.EXPECT().BumpGasUntilSafe(gomock.Any())
 
 	task := models.TaskSpec{}
@@ -492,6 +493,7 @@ func TestEthTxAdapter_Perform_CustomGas(t *testing.T) {
 
 	gasPrice := big.NewInt(187)
 	gasLimit := uint64(911)
+	maxGasLimit := uint64(1000)
 
 	ctrl := gomock.NewController(t)
 	txmMock := mocks.NewMockTxManager(ctrl)
@@ -503,6 +505,7 @@ func TestEthTxAdapter_Perform_CustomGas(t *testing.T) {
 		gomock.Any(),
 		gasPrice,
 		gasLimit,
+		maxGasLimit,
 	).Return(&models.Tx{}, nil)
 	txmMock.EXPECT().BumpGasUntilSafe(gomock.Any())
 
@@ -511,6 +514,7 @@ func TestEthTxAdapter_Perform_CustomGas(t *testing.T) {
 		FunctionSelector: models.HexToFunctionSelector("0xb3f98adc"),
 		GasPrice:         gasPrice,
 		GasLimit:         gasLimit,
+		MaxGasLimit:      maxGasLimit,
 	}
 
 	input := models.RunResult{
@@ -522,6 +526,110 @@ func TestEthTxAdapter_Perform_CustomGas(t *testing.T) {
 	assert.False(t, result.HasError())
 }
 
+func TestEthTxAdapter_Perform_WouldRevert(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	ctrl := gomock.NewController(t)
+	txmMock := mocks.NewMockTxManager(ctrl)
+	store.TxManager = txmMock
+	txmMock.EXPECT().Register(gomock.Any())
+	txmMock.EXPECT().Connected().Return(true)
+	txmMock.EXPECT().CreateTxWithGas(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil, &strpkg.RevertError{Reason: "Must have a valid requestId"})
+
+	adapter := adapters.EthTx{
+		Address:          cltest.NewAddress(),
+		FunctionSelector: models.HexToFunctionSelector("0xb3f98adc"),
+	}
+	input := models.RunResult{
+		Data:   cltest.JSONFromString(`{"value": "hello world"}`),
+		Status: models.RunStatusInProgress,
+	}
+
+	result := adapter.Perform(input, store)
+	assert.True(t, result.HasError())
+	assert.Equal(t, "transaction reverted: Must have a valid requestId", result.Error())
+}
+
+func TestEthTxAdapter_Perform_Reverted(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	hash := cltest.NewHash()
+	status := hexutil.Uint64(0)
+	receipt := &strpkg.TxReceipt{Hash: hash, BlockNumber: cltest.Int(10), Status: &status}
+
+	ctrl := gomock.NewController(t)
+	txmMock := mocks.NewMockTxManager(ctrl)
+	store.TxManager = txmMock
+	txmMock.EXPECT().Register(gomock.Any())
+	txmMock.EXPECT().Connected().Return(true)
+	txmMock.EXPECT().BumpGasUntilSafe(hash).
+		Return(receipt, &strpkg.RevertError{Reason: "Must have a valid requestId"})
+
+	adapter := adapters.EthTx{}
+	input := cltest.RunResultWithValue(hash.String())
+	input.Status = models.RunStatusPendingConfirmations
+
+	result := adapter.Perform(input, store)
+	assert.Equal(t, models.RunStatusErrored, result.Status)
+	assert.Equal(t, "transaction reverted: Must have a valid requestId", result.Error())
+	assert.True(t, result.Get("ethereumReceipts").IsArray())
+}
+
+func TestEthTxAdapter_Perform_Replaced(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	hash := cltest.NewHash()
+	ctrl := gomock.NewController(t)
+	txmMock := mocks.NewMockTxManager(ctrl)
+	store.TxManager = txmMock
+	txmMock.EXPECT().Register(gomock.Any())
+	txmMock.EXPECT().Connected().Return(true)
+	txmMock.EXPECT().BumpGasUntilSafe(hash).Return(nil, strpkg.ErrTxReplaced)
+
+	adapter := adapters.EthTx{}
+	input := cltest.RunResultWithValue(hash.String())
+	input.Status = models.RunStatusPendingConfirmations
+
+	result := adapter.Perform(input, store)
+	assert.Equal(t, models.RunStatusErrored, result.Status)
+	assert.Equal(t, strpkg.ErrTxReplaced.Error(), result.Error())
+}
+
+func TestEthTxAdapter_Perform_Cancelled(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	hash := cltest.NewHash()
+	ctrl := gomock.NewController(t)
+	txmMock := mocks.NewMockTxManager(ctrl)
+	store.TxManager = txmMock
+	txmMock.EXPECT().Register(gomock.Any())
+	txmMock.EXPECT().Connected().Return(true)
+	receipt := &strpkg.TxReceipt{Hash: cltest.NewHash(), BlockNumber: cltest.Int(10)}
+	txmMock.EXPECT().BumpGasUntilSafe(hash).Return(receipt, strpkg.ErrTxCancelled)
+
+	adapter := adapters.EthTx{}
+	input := cltest.RunResultWithValue(hash.String())
+	input.Status = models.RunStatusPendingConfirmations
+
+	result := adapter.Perform(input, store)
+	assert.Equal(t, models.RunStatusCancelled, result.Status)
+	assert.Equal(t, strpkg.ErrTxCancelled.Error(), result.Error())
+	assert.Equal(t, receipt.Hash.String(), result.Get("value").String())
+}
+
 func TestEthTxAdapter_Perform_NotConnected(t *testing.T) {
 	t.Parallel()
 
@@ -538,3 +646,56 @@ func TestEthTxAdapter_Perform_NotConnected(t *testing.T) {
 	assert.False(t, data.HasError())
 	assert.Equal(t, models.RunStatusPendingConnection, data.Status)
 }
+
+func TestEthTxAdapter_Simulate(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplicationWithKeyStore()
+	defer cleanup()
+	store := app.Store
+
+	address := cltest.NewAddress()
+	from := cltest.NewAddress()
+	wantData := "0x" +
+		"b3f98adc" +
+		"0000000000000000000000000000000000000000000000000045746736453745" +
+		"0000000000000000000000000000000000000000000000000000009786856756"
+
+	ethMock := app.MockEthClient()
+	ethMock.Register("eth_getTransactionCount", `0x0100`)
+	assert.Nil(t, app.StartAndConnect())
+	ethMock.Register("eth_call", hexutil.Bytes{0x01},
+		func(_ interface{}, data ...interface{}) error {
+			b, err := json.Marshal(data[0].([]interface{})[0])
+			require.NoError(t, err)
+			var call map[string]string
+			require.NoError(t, json.Unmarshal(b, &call))
+			assert.Equal(t, strings.ToLower(from.Hex()), call["from"])
+			assert.Equal(t, strings.ToLower(address.Hex()), call["to"])
+			assert.Equal(t, wantData, call["data"])
+			return nil
+		})
+
+	adapter := adapters.EthTx{
+		Address:          address,
+		DataPrefix:       hexutil.MustDecode("0x0000000000000000000000000000000000000000000000000045746736453745"),
+		FunctionSelector: models.HexToFunctionSelector("b3f98adc"),
+	}
+	input := cltest.RunResultWithValue("0x9786856756")
+
+	output := adapter.Simulate(input, store, adapters.SimulationOptions{})
+	require.False(t, output.HasError())
+	assert.Equal(t, wantData, output.Get("value").String())
+	assert.Equal(t, address.Hex(), output.Get("to").String())
+	assert.False(t, output.Get("ethCallResult").Exists())
+
+	opts := adapters.SimulationOptions{EthCall: true, From: from}
+	output = adapter.Simulate(input, store, opts)
+	require.False(t, output.HasError())
+	assert.Equal(t, "0x01", output.Get("ethCallResult").String())
+
+	txs, err := store.TxFrom(cltest.GetAccountAddress(store))
+	assert.NoError(t, err)
+	assert.Len(t, txs, 0)
+	ethMock.EventuallyAllCalled(t)
+}
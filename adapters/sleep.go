@@ -18,6 +18,13 @@ func (adapter *Sleep) Perform(input models.RunResult, str *store.Store) models.R
 	return input
 }
 
+// Simulate returns the input RunResult without waiting, so that the tasks
+// following the sleep can be simulated.
+func (adapter *Sleep) Simulate(input models.RunResult, _ *store.Store, _ SimulationOptions) models.RunResult {
+	input.Status = models.RunStatusCompleted
+	return input
+}
+
 // Duration returns the amount of sleeping this task should be paused for.
 func (adapter *Sleep) Duration() time.Duration {
 	return adapter.Until.DurationFromNow()
@@ -92,6 +92,41 @@ func NewApp(client *Client) *cli.App {
 			Usage:   "Create job spec from JSON",
 			Action:  client.CreateJobSpec,
 		},
+		{
+			Name:   "simulate",
+			Usage:  "Execute the tasks of a job spec without saving a run or sending transactions: <JSON blob | JSON filepath> [JSON input | JSON filepath]",
+			Action: client.SimulateJobSpec,
+			Flags: []cli.Flag{
+				cli.BoolFlag{
+					Name:  "eth-call",
+					Usage: "execute the transactions of ethtx tasks with eth_call",
+				},
+				cli.StringFlag{
+					Name:  "from",
+					Usage: "address to execute the transactions of ethtx tasks from",
+				},
+			},
+		},
+		{
+			Name:   "update",
+			Usage:  "Replace the initiators and tasks of a job spec with a new version from JSON: <SpecID> <JSON blob | JSON filepath>",
+			Action: client.UpdateJobSpec,
+		},
+		{
+			Name:   "archive",
+			Usage:  "Archive a job spec, stopping it from running while keeping its run history: <SpecID>",
+			Action: client.ArchiveJobSpec,
+		},
+		{
+			Name:   "pause",
+			Usage:  "Stop a job spec from running until it is resumed: <SpecID>",
+			Action: client.PauseJobSpec,
+		},
+		{
+			Name:   "resume",
+			Usage:  "Let a paused job spec run again: <SpecID>",
+			Action: client.ResumeJobSpec,
+		},
 		{
 			Name:    "run",
 			Aliases: []string{"r"},
@@ -104,6 +139,27 @@ func NewApp(client *Client) *cli.App {
 			Usage:   "Show a job run for a RunID",
 			Action:  client.ShowJobRun,
 		},
+		{
+			Name:   "resumerun",
+			Usage:  "Resume an errored job run from its errored tasks: <RunID> [JSON params | JSON filepath]",
+			Action: client.ResumeJobRun,
+		},
+		{
+			Name:   "cancelrun",
+			Usage:  "Cancel a job run that has not finished: <RunID>",
+			Action: client.CancelJobRun,
+			Flags: []cli.Flag{
+				cli.BoolFlag{
+					Name:  "force",
+					Usage: "cancel even if the run has already broadcast an ethereum transaction",
+				},
+			},
+		},
+		{
+			Name:   "backfill",
+			Usage:  "Run a log initiated job for its historical logs: <SpecID> <fromBlock> <toBlock>",
+			Action: client.BackfillLogs,
+		},
 		{
 			Name:   "backup",
 			Usage:  "Backup the database of the running node",
@@ -141,6 +197,21 @@ func NewApp(client *Client) *cli.App {
 			Usage:  "Removes a specific bridge",
 			Action: client.RemoveBridge,
 		},
+		{
+			Name:   "setsecret",
+			Usage:  "Encrypt and store a secret that task params reference as {{secret \"<name>\"}}: <name> <value>",
+			Action: client.SetSecret,
+		},
+		{
+			Name:   "getsecrets",
+			Usage:  "List the names of the secrets stored in the node",
+			Action: client.GetSecrets,
+		},
+		{
+			Name:   "removesecret",
+			Usage:  "Removes a specific secret",
+			Action: client.RemoveSecret,
+		},
 		{
 			Name:    "agree",
 			Aliases: []string{"createsa"},
@@ -181,15 +252,70 @@ func NewApp(client *Client) *cli.App {
 				},
 			},
 		},
-	}
-
-	if client.Config.Dev() {
-		createextrakey := cli.Command{
+		{
+			Name:   "keys",
+			Usage:  "List the keys in the node's keystore with their balances",
+			Action: client.GetKeys,
+		},
+		{
 			Name:   "createextrakey",
 			Usage:  "Create a key in the node's keystore alongside the existing key; to create an original key, just run the node",
 			Action: client.CreateExtraKey,
-		}
-		app.Commands = append(app.Commands, createextrakey)
+		},
+		{
+			Name:   "importkey",
+			Usage:  "Import a key file, encrypted with the node's password, into the running node's keystore: <filepath>",
+			Action: client.ImportEncryptedKey,
+		},
+		{
+			Name:   "exportkey",
+			Usage:  "Export a key, encrypted with the node's password, to a file: <address> <filepath>",
+			Action: client.ExportKey,
+		},
+		{
+			Name:   "disablekey",
+			Usage:  "Keep a key from sending new transactions: <address>",
+			Action: client.DisableKey,
+		},
+		{
+			Name:   "enablekey",
+			Usage:  "Allow a disabled key to send new transactions again: <address>",
+			Action: client.EnableKey,
+		},
+		{
+			Name:   "deletekey",
+			Usage:  "Delete a key with no unconfirmed transactions from the node's keystore; export it first to keep it: <address>",
+			Action: client.DeleteKey,
+		},
+		{
+			Name:   "txs",
+			Usage:  "List the transactions in descending order",
+			Action: client.GetTransactions,
+			Flags: []cli.Flag{
+				cli.IntFlag{
+					Name:  "page",
+					Usage: "page of results to display",
+				},
+				cli.StringFlag{
+					Name:  "status",
+					Usage: "only list confirmed or unconfirmed transactions",
+				},
+				cli.StringFlag{
+					Name:  "account",
+					Usage: "only list transactions from this address",
+				},
+			},
+		},
+		{
+			Name:   "speeduptx",
+			Usage:  "Replace an unconfirmed transaction at a higher gas price: <TxHash> <gasPrice>",
+			Action: client.SpeedUpTransaction,
+		},
+		{
+			Name:   "canceltx",
+			Usage:  "Cancel an unconfirmed transaction by replacing it with a zero value transaction to its sender: <TxHash> [gasPrice]",
+			Action: client.CancelTransaction,
+		},
 	}
 
 	return app
@@ -106,7 +106,7 @@ type HTTPClient interface {
 	Get(string, ...map[string]string) (*http.Response, error)
 	Post(string, io.Reader) (*http.Response, error)
 	Patch(string, io.Reader, ...map[string]string) (*http.Response, error)
-	Delete(string) (*http.Response, error)
+	Delete(string, ...io.Reader) (*http.Response, error)
 }
 
 type authenticatedHTTPClient struct {
@@ -140,8 +140,12 @@ func (h *authenticatedHTTPClient) Patch(path string, body io.Reader, headers ...
 	return h.doRequest("PATCH", path, body, headers...)
 }
 
-// Delete performs an HTTP Delete using the authenticated HTTP client's cookie.
-func (h *authenticatedHTTPClient) Delete(path string) (*http.Response, error) {
+// Delete performs an HTTP Delete using the authenticated HTTP client's cookie,
+// sending the body if one is passed.
+func (h *authenticatedHTTPClient) Delete(path string, body ...io.Reader) (*http.Response, error) {
+	if len(body) > 0 {
+		return h.doRequest("DELETE", path, body[0])
+	}
 	return h.doRequest("DELETE", path, nil)
 }
 
@@ -80,6 +80,49 @@ func (cli *Client) ShowJobRun(c *clipkg.Context) error {
 	return cli.renderAPIResponse(resp, &job)
 }
 
+// ResumeJobRun resumes the given errored JobRun from its errored tasks, with
+// optional JSON params for those tasks.
+func (cli *Client) ResumeJobRun(c *clipkg.Context) error {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("Must pass in RunID [JSON blob | JSON filepath]"))
+	}
+
+	buf := bytes.NewBufferString("")
+	if c.NArg() > 1 {
+		jbuf, err := getBufferFromJSON(c.Args().Get(1))
+		if err != nil {
+			return cli.errorOut(err)
+		}
+		buf = jbuf
+	}
+
+	resp, err := cli.HTTP.Post("/v2/runs/"+c.Args().First()+"/resume", buf)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer resp.Body.Close()
+	var run presenters.JobRun
+	return cli.renderAPIResponse(resp, &run)
+}
+
+// CancelJobRun cancels the given JobRun if it has not finished.
+func (cli *Client) CancelJobRun(c *clipkg.Context) error {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("Must pass the RunID to cancel"))
+	}
+	url := "/v2/runs/" + c.Args().First() + "/cancel"
+	if c.Bool("force") {
+		url += "?force=true"
+	}
+	resp, err := cli.HTTP.Post(url, nil)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer resp.Body.Close()
+	var run presenters.JobRun
+	return cli.renderAPIResponse(resp, &run)
+}
+
 // ShowJobSpec returns the status of the given JobID.
 func (cli *Client) ShowJobSpec(c *clipkg.Context) error {
 	if !c.Args().Present() {
@@ -126,6 +169,110 @@ func (cli *Client) CreateJobSpec(c *clipkg.Context) error {
 	return cli.renderAPIResponse(resp, &js)
 }
 
+// SimulateJobSpec executes the tasks of a JobSpec based on JSON input on the
+// node, with optional JSON sample input, without saving a run or sending
+// transactions.
+func (cli *Client) SimulateJobSpec(c *clipkg.Context) error {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("Must pass in JSON or filepath [JSON input | JSON filepath]"))
+	}
+
+	spec, err := getBufferFromJSON(c.Args().First())
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	request := struct {
+		Spec    json.RawMessage `json:"spec"`
+		Input   json.RawMessage `json:"input,omitempty"`
+		EthCall bool            `json:"ethCall"`
+		From    string          `json:"from,omitempty"`
+	}{
+		Spec:    spec.Bytes(),
+		EthCall: c.Bool("eth-call"),
+		From:    c.String("from"),
+	}
+	if c.NArg() > 1 {
+		input, err := getBufferFromJSON(c.Args().Get(1))
+		if err != nil {
+			return cli.errorOut(err)
+		}
+		request.Input = input.Bytes()
+	}
+
+	buf, err := json.Marshal(request)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	resp, err := cli.HTTP.Post("/v2/simulations", bytes.NewBuffer(buf))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer resp.Body.Close()
+
+	var simulation models.JobSimulation
+	return cli.renderAPIResponse(resp, &simulation)
+}
+
+// UpdateJobSpec replaces the JobSpec with a new version based on JSON input
+func (cli *Client) UpdateJobSpec(c *clipkg.Context) error {
+	if c.NArg() < 2 {
+		return cli.errorOut(errors.New("Must pass in SpecID and JSON or filepath"))
+	}
+
+	buf, err := getBufferFromJSON(c.Args().Get(1))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	resp, err := cli.HTTP.Patch("/v2/specs/"+c.Args().First(), buf)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer resp.Body.Close()
+
+	var js presenters.JobSpec
+	return cli.renderAPIResponse(resp, &js)
+}
+
+// ArchiveJobSpec archives the given JobSpec, keeping its runs.
+func (cli *Client) ArchiveJobSpec(c *clipkg.Context) error {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("Must pass the job id to be archived"))
+	}
+	resp, err := cli.HTTP.Delete("/v2/specs/" + c.Args().First())
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer resp.Body.Close()
+	var job presenters.JobSpec
+	return cli.renderAPIResponse(resp, &job)
+}
+
+// PauseJobSpec stops the given JobSpec from running until it is resumed.
+func (cli *Client) PauseJobSpec(c *clipkg.Context) error {
+	return cli.changeJobSpecStatus(c, "pause")
+}
+
+// ResumeJobSpec lets the given paused JobSpec run again.
+func (cli *Client) ResumeJobSpec(c *clipkg.Context) error {
+	return cli.changeJobSpecStatus(c, "resume")
+}
+
+func (cli *Client) changeJobSpecStatus(c *clipkg.Context, action string) error {
+	if !c.Args().Present() {
+		return cli.errorOut(fmt.Errorf("Must pass the job id to %s", action))
+	}
+	resp, err := cli.HTTP.Post("/v2/specs/"+c.Args().First()+"/"+action, nil)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer resp.Body.Close()
+	var job presenters.JobSpec
+	return cli.renderAPIResponse(resp, &job)
+}
+
 // CreateJobRun creates job run based on SpecID and optional JSON
 func (cli *Client) CreateJobRun(c *clipkg.Context) error {
 	if !c.Args().Present() {
@@ -150,6 +297,44 @@ func (cli *Client) CreateJobRun(c *clipkg.Context) error {
 	return cli.renderAPIResponse(resp, &run)
 }
 
+// BackfillLogs creates a task on the node to run a log initiated job for
+// every matching log between two blocks.
+func (cli *Client) BackfillLogs(c *clipkg.Context) error {
+	if c.NArg() != 3 {
+		return cli.errorOut(errors.New("backfill expects three arguments: a SpecID, a from block and a to block"))
+	}
+
+	fromBlock, err := strconv.ParseUint(c.Args().Get(1), 10, 64)
+	if err != nil {
+		return cli.errorOut(multierr.Combine(
+			errors.New("while parsing from block"), err))
+	}
+	toBlock, err := strconv.ParseUint(c.Args().Get(2), 10, 64)
+	if err != nil {
+		return cli.errorOut(multierr.Combine(
+			errors.New("while parsing to block"), err))
+	}
+
+	request := models.BackfillLogsRequest{
+		JobID:     c.Args().First(),
+		FromBlock: fromBlock,
+		ToBlock:   toBlock,
+	}
+
+	requestData, err := json.Marshal(request)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	resp, err := cli.HTTP.Post("/v2/bulk_backfill_logs", bytes.NewBuffer(requestData))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer resp.Body.Close()
+
+	return cli.printResponseBody(resp)
+}
+
 // BackupDatabase streams a backup of the node's db to the passed filepath.
 func (cli *Client) BackupDatabase(c *clipkg.Context) error {
 	if !c.Args().Present() {
@@ -253,6 +438,59 @@ func (cli *Client) RemoveBridge(c *clipkg.Context) error {
 	return cli.renderResponse(resp, &bridge)
 }
 
+// SetSecret encrypts and stores a secret that task params can reference by
+// name.
+func (cli *Client) SetSecret(c *clipkg.Context) error {
+	if c.NArg() != 2 {
+		return cli.errorOut(errors.New("setsecret expects two arguments: a name and a value"))
+	}
+
+	request := models.SecretRequest{
+		Name:  c.Args().Get(0),
+		Value: c.Args().Get(1),
+	}
+	buf, err := json.Marshal(request)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	resp, err := cli.HTTP.Post("/v2/secrets", bytes.NewBuffer(buf))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer resp.Body.Close()
+
+	var secret presenters.Secret
+	return cli.renderAPIResponse(resp, &secret)
+}
+
+// GetSecrets lists the names of the secrets stored in the node.
+func (cli *Client) GetSecrets(c *clipkg.Context) error {
+	resp, err := cli.HTTP.Get("/v2/secrets")
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer resp.Body.Close()
+
+	var secrets []presenters.Secret
+	return cli.renderAPIResponse(resp, &secrets)
+}
+
+// RemoveSecret removes a specific secret by name.
+func (cli *Client) RemoveSecret(c *clipkg.Context) error {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("Must pass the name of the secret to be removed"))
+	}
+	resp, err := cli.HTTP.Delete("/v2/secrets/" + c.Args().First())
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer resp.Body.Close()
+
+	var secret presenters.Secret
+	return cli.renderAPIResponse(resp, &secret)
+}
+
 // RemoteLogin creates a cookie session to run remote commands.
 func (cli *Client) RemoteLogin(c *clipkg.Context) error {
 	sessionRequest, err := cli.buildSessionRequest(c.String("file"))
@@ -401,6 +639,70 @@ func (cli *Client) GetTxAttempts(c *clipkg.Context) error {
 	return cli.errorOut(cli.Render(&attempts))
 }
 
+// GetTransactions returns the list of transactions in descending order,
+// taking optional page, status and account parameters
+func (cli *Client) GetTransactions(c *clipkg.Context) error {
+	uri := url.URL{Path: "/v2/transactions"}
+	q := uri.Query()
+	if status := c.String("status"); status != "" {
+		q.Set("status", status)
+	}
+	if account := c.String("account"); account != "" {
+		q.Set("account", account)
+	}
+	uri.RawQuery = q.Encode()
+
+	var links jsonapi.Links
+	txs := []presenters.Tx{}
+	err := cli.getPage(uri.String(), c.Int("page"), &txs, &links)
+	if err != nil {
+		return err
+	}
+	return cli.errorOut(cli.Render(&txs))
+}
+
+// SpeedUpTransaction replaces the given unconfirmed transaction with a new
+// attempt at a higher gas price.
+func (cli *Client) SpeedUpTransaction(c *clipkg.Context) error {
+	if c.NArg() != 2 {
+		return cli.errorOut(errors.New("Must pass the transaction hash and gas price in wei"))
+	}
+	return cli.replaceTransaction(c.Args().First(), "speed_up", c.Args().Get(1))
+}
+
+// CancelTransaction replaces the given unconfirmed transaction with a zero
+// value transaction to its sender, at the optional gas price or a bumped one,
+// cancelling the runs waiting for it.
+func (cli *Client) CancelTransaction(c *clipkg.Context) error {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("Must pass the transaction hash to cancel"))
+	}
+	return cli.replaceTransaction(c.Args().First(), "cancel", c.Args().Get(1))
+}
+
+func (cli *Client) replaceTransaction(hash, action, gasPrice string) error {
+	var request models.ReplaceTxRequest
+	if gasPrice != "" {
+		var value models.Int
+		if err := value.UnmarshalText([]byte(gasPrice)); err != nil {
+			return cli.errorOut(fmt.Errorf("Invalid gas price %s: %v", gasPrice, err))
+		}
+		request.GasPrice = &value
+	}
+
+	requestData, err := json.Marshal(request)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	resp, err := cli.HTTP.Post("/v2/transactions/"+hash+"/"+action, bytes.NewBuffer(requestData))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer resp.Body.Close()
+	var tx presenters.Tx
+	return cli.renderAPIResponse(resp, &tx)
+}
+
 func (cli *Client) buildSessionRequest(flag string) (models.SessionRequest, error) {
 	if len(flag) > 0 {
 		return cli.FileSessionRequestBuilder.Build(flag)
@@ -532,3 +834,136 @@ func (cli *Client) CreateExtraKey(c *clipkg.Context) error {
 
 	return cli.printResponseBody(resp)
 }
+
+// GetKeys lists the keys in the node's keystore with their ETH & LINK
+// balances, and whether they are kept from sending new transactions.
+func (cli *Client) GetKeys(c *clipkg.Context) error {
+	resp, err := cli.HTTP.Get("/v2/keys")
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer resp.Body.Close()
+
+	var links jsonapi.Links
+	keys := []presenters.Key{}
+	if err = cli.deserializeAPIResponse(resp, &keys, &links); err != nil {
+		return err
+	}
+	return cli.errorOut(cli.Render(&keys))
+}
+
+// ImportEncryptedKey adds the key in the given JSON file, encrypted with the
+// node's password, to the running node's keystore.
+func (cli *Client) ImportEncryptedKey(c *clipkg.Context) error {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("Must pass in filepath to key"))
+	}
+	keyJSON, err := fromFile(c.Args().First())
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	password := cli.PasswordPrompter.Prompt()
+	request := models.ImportKeyRequest{
+		CurrentPassword: password,
+		KeyJSON:         keyJSON.Bytes(),
+		KeyPassword:     password,
+	}
+	requestData, err := json.Marshal(request)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	resp, err := cli.HTTP.Post("/v2/key_imports", bytes.NewBuffer(requestData))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer resp.Body.Close()
+
+	return cli.printResponseBody(resp)
+}
+
+// ExportKey writes the key with the given address, encrypted with the node's
+// password, to the given JSON file.
+func (cli *Client) ExportKey(c *clipkg.Context) error {
+	if c.NArg() != 2 {
+		return cli.errorOut(errors.New("Must pass in the address of the key and the filepath to export it to"))
+	}
+	dst, err := homedir.Expand(c.Args().Get(1))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	request := models.ExportKeyRequest{CurrentPassword: cli.PasswordPrompter.Prompt()}
+	requestData, err := json.Marshal(request)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	resp, err := cli.HTTP.Post("/v2/keys/"+c.Args().First()+"/export", bytes.NewBuffer(requestData))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer resp.Body.Close()
+
+	keyJSON, err := cli.parseResponse(resp)
+	if err != nil {
+		return err
+	}
+	return cli.errorOut(ioutil.WriteFile(dst, keyJSON, 0600))
+}
+
+// DisableKey keeps the key with the given address from sending new
+// transactions, while those it has sent are still bumped until confirmed.
+func (cli *Client) DisableKey(c *clipkg.Context) error {
+	return cli.updateKey(c, true)
+}
+
+// EnableKey allows the key with the given address to send new transactions.
+func (cli *Client) EnableKey(c *clipkg.Context) error {
+	return cli.updateKey(c, false)
+}
+
+func (cli *Client) updateKey(c *clipkg.Context, sendDisabled bool) error {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("Must pass in the address of the key"))
+	}
+
+	request := models.UpdateKeyRequest{
+		CurrentPassword: cli.PasswordPrompter.Prompt(),
+		SendDisabled:    sendDisabled,
+	}
+	requestData, err := json.Marshal(request)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	resp, err := cli.HTTP.Patch("/v2/keys/"+c.Args().First(), bytes.NewBuffer(requestData))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer resp.Body.Close()
+
+	return cli.printResponseBody(resp)
+}
+
+// DeleteKey deletes the key with the given address from the node's keystore.
+func (cli *Client) DeleteKey(c *clipkg.Context) error {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("Must pass in the address of the key"))
+	}
+
+	request := models.DeleteKeyRequest{CurrentPassword: cli.PasswordPrompter.Prompt()}
+	requestData, err := json.Marshal(request)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	resp, err := cli.HTTP.Delete("/v2/keys/"+c.Args().First(), bytes.NewBuffer(requestData))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer resp.Body.Close()
+
+	return cli.printResponseBody(resp)
+}
@@ -86,6 +86,119 @@ func TestClient_ShowJobRun_NotFound(t *testing.T) {
 	assert.Empty(t, r.Renders)
 }
 
+func TestClient_CancelJobRun(t *testing.T) {
+	t.Parallel()
+	app, cleanup := cltest.NewApplication()
+	defer cleanup()
+
+	j, initr := cltest.NewJobWithWebInitiator()
+	assert.NoError(t, app.Store.SaveJob(&j))
+	jr := j.NewRun(initr)
+	jr.Status = models.RunStatusPendingSleep
+	assert.NoError(t, app.Store.SaveJobRun(&jr))
+
+	client, r := app.NewClientAndRenderer()
+
+	set := flag.NewFlagSet("test", 0)
+	set.Bool("force", false, "")
+	set.Parse([]string{jr.ID})
+	c := cli.NewContext(nil, set, nil)
+	assert.NoError(t, client.CancelJobRun(c))
+	assert.Equal(t, 1, len(r.Renders))
+	assert.Equal(t, models.RunStatusCancelled, r.Renders[0].(*presenters.JobRun).Status)
+}
+
+func TestClient_ResumeJobRun(t *testing.T) {
+	t.Parallel()
+	app, cleanup := cltest.NewApplication()
+	defer cleanup()
+	app.Start()
+
+	j, initr := cltest.NewJobWithWebInitiator()
+	assert.NoError(t, app.Store.SaveJob(&j))
+	jr := j.NewRun(initr)
+	jr.TaskRuns[0] = jr.TaskRuns[0].ApplyResult(models.RunResult{Status: models.RunStatusErrored})
+	jr.Status = models.RunStatusErrored
+	assert.NoError(t, app.Store.SaveJobRun(&jr))
+
+	client, r := app.NewClientAndRenderer()
+
+	set := flag.NewFlagSet("test", 0)
+	set.Parse([]string{jr.ID, `{"extra":"value"}`})
+	c := cli.NewContext(nil, set, nil)
+	assert.NoError(t, client.ResumeJobRun(c))
+	assert.Equal(t, 1, len(r.Renders))
+	assert.False(t, r.Renders[0].(*presenters.JobRun).Status.Errored())
+}
+
+func TestClient_PauseResumeArchiveJobSpec(t *testing.T) {
+	t.Parallel()
+	app, cleanup := cltest.NewApplication()
+	defer cleanup()
+
+	j := cltest.NewJob()
+	j.Initiators = []models.Initiator{{Type: models.InitiatorWeb}}
+	assert.NoError(t, app.AddJob(j))
+
+	client, r := app.NewClientAndRenderer()
+
+	set := flag.NewFlagSet("test", 0)
+	set.Parse([]string{j.ID})
+	c := cli.NewContext(nil, set, nil)
+
+	assert.NoError(t, client.PauseJobSpec(c))
+	assert.Equal(t, models.JobSpecStatusPaused, r.Renders[0].(*presenters.JobSpec).Status)
+	assert.NoError(t, client.ResumeJobSpec(c))
+	assert.Equal(t, models.JobSpecStatusActive, r.Renders[1].(*presenters.JobSpec).Status)
+	assert.NoError(t, client.ArchiveJobSpec(c))
+	assert.Equal(t, models.JobSpecStatusArchived, r.Renders[2].(*presenters.JobSpec).Status)
+}
+
+func TestClient_UpdateJobSpec(t *testing.T) {
+	t.Parallel()
+	app, cleanup := cltest.NewApplication()
+	defer cleanup()
+
+	j := cltest.NewJob()
+	j.Initiators = []models.Initiator{{Type: models.InitiatorWeb}}
+	assert.NoError(t, app.AddJob(j))
+
+	client, r := app.NewClientAndRenderer()
+
+	set := flag.NewFlagSet("test", 0)
+	set.Parse([]string{j.ID, "../internal/fixtures/web/hello_world_job.json"})
+	c := cli.NewContext(nil, set, nil)
+
+	assert.NoError(t, client.UpdateJobSpec(c))
+	assert.Equal(t, 1, len(r.Renders))
+	assert.Equal(t, uint64(2), r.Renders[0].(*presenters.JobSpec).Version)
+}
+
+func TestClient_SimulateJobSpec(t *testing.T) {
+	t.Parallel()
+	app, cleanup := cltest.NewApplication()
+	defer cleanup()
+
+	client, r := app.NewClientAndRenderer()
+
+	set := flag.NewFlagSet("test", 0)
+	set.Parse([]string{
+		`{"initiators":[{"type":"web"}],"tasks":[{"type":"noop"}]}`,
+		`{"value":"hello"}`,
+	})
+	c := cli.NewContext(nil, set, nil)
+
+	assert.NoError(t, client.SimulateJobSpec(c))
+	require.Equal(t, 1, len(r.Renders))
+	sim := r.Renders[0].(*models.JobSimulation)
+	assert.Equal(t, models.RunStatusCompleted, sim.Status)
+	assert.Equal(t, "hello", sim.Result.Data.Get("value").String())
+
+	count, err := app.Store.JobRunsCount()
+	assert.NoError(t, err)
+	assert.Equal(t, 0, count)
+}
+
 func TestClient_ShowJobSpec_Exists(t *testing.T) {
 	app, cleanup := cltest.NewApplication()
 	defer cleanup()
@@ -494,6 +607,43 @@ func first(a models.JobSpec, b interface{}) models.JobSpec {
 	return a
 }
 
+func TestClient_BackfillLogs(t *testing.T) {
+	t.Parallel()
+	app, cleanup := cltest.NewApplication()
+	defer cleanup()
+	client, _ := app.NewClientAndRenderer()
+
+	logJob, _ := cltest.NewJobWithLogInitiator()
+	assert.NoError(t, app.Store.SaveJob(&logJob))
+	webJob, _ := cltest.NewJobWithWebInitiator()
+	assert.NoError(t, app.Store.SaveJob(&webJob))
+
+	tests := []struct {
+		name    string
+		args    []string
+		errored bool
+	}{
+		{"success", []string{logJob.ID, "1", "10"}, false},
+		{"not log initiated", []string{webJob.ID, "1", "10"}, true},
+		{"bad block", []string{logJob.ID, "one", "10"}, true},
+		{"missing to block", []string{logJob.ID, "1"}, true},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			set := flag.NewFlagSet("backfill", 0)
+			set.Parse(test.args)
+			c := cli.NewContext(nil, set, nil)
+			if test.errored {
+				assert.Error(t, client.BackfillLogs(c))
+			} else {
+				assert.NoError(t, client.BackfillLogs(c))
+			}
+		})
+	}
+}
+
 func TestClient_SendEther(t *testing.T) {
 	app, cleanup, _ := setupWithdrawalsApplication()
 	defer cleanup()
@@ -595,3 +745,135 @@ func TestClient_CreateExtraKey(t *testing.T) {
 
 	assert.NoError(t, client.CreateExtraKey(c))
 }
+
+func TestClient_SetSecret(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplicationWithKeyStore()
+	defer cleanup()
+	client, r := app.NewClientAndRenderer()
+
+	set := flag.NewFlagSet("test", 0)
+	set.Parse([]string{"cmc_key"})
+	c := cli.NewContext(nil, set, nil)
+	assert.Error(t, client.SetSecret(c))
+
+	set = flag.NewFlagSet("test", 0)
+	set.Parse([]string{"cmc_key", "s3cr3t"})
+	c = cli.NewContext(nil, set, nil)
+	require.NoError(t, client.SetSecret(c))
+	require.Equal(t, 1, len(r.Renders))
+	assert.Equal(t, "cmc_key", r.Renders[0].(*presenters.Secret).Name)
+
+	_, err := app.Store.FindSecret("cmc_key")
+	require.NoError(t, err)
+
+	require.NoError(t, client.GetSecrets(cltest.EmptyCLIContext()))
+	secrets := *r.Renders[1].(*[]presenters.Secret)
+	require.Equal(t, 1, len(secrets))
+	assert.Equal(t, "cmc_key", secrets[0].Name)
+
+	set = flag.NewFlagSet("test", 0)
+	set.Parse([]string{"cmc_key"})
+	c = cli.NewContext(nil, set, nil)
+	require.NoError(t, client.RemoveSecret(c))
+	_, err = app.Store.FindSecret("cmc_key")
+	assert.Error(t, err)
+}
+
+func TestClient_GetTransactions(t *testing.T) {
+	app, cleanup := cltest.NewApplicationWithKeyStore()
+	defer cleanup()
+
+	store := app.GetStore()
+	from := cltest.GetAccountAddress(store)
+	tx := cltest.CreateTxAndAttempt(store, from, 1)
+
+	client, r := app.NewClientAndRenderer()
+
+	set := flag.NewFlagSet("test txs", 0)
+	set.Int("page", 1, "doc")
+	set.String("status", "unconfirmed", "doc")
+	set.String("account", from.Hex(), "doc")
+	c := cli.NewContext(nil, set, nil)
+	assert.NoError(t, client.GetTransactions(c))
+
+	renderedTxs := *r.Renders[0].(*[]presenters.Tx)
+	require.Equal(t, 1, len(renderedTxs))
+	assert.Equal(t, tx.Hash.Hex(), renderedTxs[0].Hash.Hex())
+
+	set = flag.NewFlagSet("test txs", 0)
+	set.String("status", "confirmed", "doc")
+	c = cli.NewContext(nil, set, nil)
+	assert.NoError(t, client.GetTransactions(c))
+
+	renderedTxs = *r.Renders[1].(*[]presenters.Tx)
+	assert.Equal(t, 0, len(renderedTxs))
+}
+
+func TestClient_GetKeys(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplicationWithKeyStore()
+	defer cleanup()
+	ethMock := app.MockEthClient()
+	ethMock.Register("eth_getBalance", "0x0100")
+	ethMock.Register("eth_call", "0x0100")
+
+	client, r := app.NewClientAndRenderer()
+	assert.NoError(t, client.GetKeys(cli.NewContext(nil, flag.NewFlagSet("test", 0), nil)))
+
+	keys := *r.Renders[0].(*[]presenters.Key)
+	require.Len(t, keys, 1)
+	assert.Equal(t, cltest.GetAccountAddress(app.Store).Hex(), keys[0].Address)
+	assert.False(t, keys[0].SendDisabled)
+}
+
+func TestClient_DisableKey(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplicationWithKeyStore()
+	defer cleanup()
+	address := cltest.GetAccountAddress(app.Store)
+
+	client, _ := app.NewClientAndRenderer()
+	client.PasswordPrompter = cltest.MockPasswordPrompter{Password: cltest.Password}
+
+	set := flag.NewFlagSet("test", 0)
+	set.Parse([]string{address.Hex()})
+	c := cli.NewContext(nil, set, nil)
+	require.NoError(t, client.DisableKey(c))
+
+	key, err := app.Store.FindKey(address)
+	require.NoError(t, err)
+	assert.True(t, key.SendDisabled)
+
+	require.NoError(t, client.EnableKey(c))
+	key, err = app.Store.FindKey(address)
+	require.NoError(t, err)
+	assert.False(t, key.SendDisabled)
+}
+
+func TestClient_DeleteKey(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplicationWithKeyStore()
+	defer cleanup()
+	account, err := app.Store.KeyStore.NewAccount(cltest.Password)
+	require.NoError(t, err)
+	app.Store.TxManager.AddAccount(account)
+
+	client, _ := app.NewClientAndRenderer()
+	client.PasswordPrompter = cltest.MockPasswordPrompter{Password: cltest.Password}
+
+	set := flag.NewFlagSet("test", 0)
+	set.Parse([]string{cltest.GetAccountAddress(app.Store).Hex()})
+	c := cli.NewContext(nil, set, nil)
+	assert.Error(t, client.DeleteKey(c))
+
+	set = flag.NewFlagSet("test", 0)
+	set.Parse([]string{account.Address.Hex()})
+	c = cli.NewContext(nil, set, nil)
+	require.NoError(t, client.DeleteKey(c))
+	assert.Len(t, app.Store.KeyStore.Accounts(), 1)
+}
@@ -54,10 +54,22 @@ func (rt RendererTable) Render(v interface{}) error {
 		rt.renderBridges(*typed)
 	case *[]presenters.AccountBalance:
 		rt.renderAccountBalances(*typed)
+	case *[]presenters.Key:
+		rt.renderKeys(*typed)
 	case *presenters.ServiceAgreement:
 		rt.renderServiceAgreement(*typed)
 	case *[]models.TxAttempt:
 		rt.renderTxAttempts(*typed)
+	case *presenters.Tx:
+		rt.renderTxs([]presenters.Tx{*typed})
+	case *[]presenters.Tx:
+		rt.renderTxs(*typed)
+	case *models.JobSimulation:
+		rt.renderJobSimulation(*typed)
+	case *presenters.Secret:
+		rt.renderSecrets([]presenters.Secret{*typed})
+	case *[]presenters.Secret:
+		rt.renderSecrets(*typed)
 	default:
 		return fmt.Errorf("Unable to render object of type %T: %v", typed, typed)
 	}
@@ -149,9 +161,11 @@ func (rt RendererTable) renderJobRun(run presenters.JobRun) error {
 }
 
 func (rt RendererTable) renderJobSingles(j presenters.JobSpec) error {
-	table := rt.newTable([]string{"ID", "Created At", "Start At", "End At"})
+	table := rt.newTable([]string{"ID", "Version", "Status", "Created At", "Start At", "End At"})
 	table.Append([]string{
 		j.ID,
+		fmt.Sprint(j.Version),
+		string(j.Status),
 		j.FriendlyCreatedAt(),
 		j.FriendlyStartAt(),
 		j.FriendlyEndAt(),
@@ -206,6 +220,35 @@ func (rt RendererTable) renderJobRuns(runs []presenters.JobRun) error {
 	return nil
 }
 
+func (rt RendererTable) renderJobSimulation(sim models.JobSimulation) error {
+	table := rt.newTable([]string{"Type", "Status", "Input", "Output", "Error"})
+	for _, ts := range sim.Tasks {
+		table.Append([]string{
+			ts.Task.Type.String(),
+			string(ts.Output.Status),
+			ts.Input.Data.String(),
+			ts.Output.Data.String(),
+			ts.Output.ErrorMessage.String,
+		})
+	}
+
+	render(fmt.Sprintf("Simulation (%s)", sim.Status), table)
+	return nil
+}
+
+func (rt RendererTable) renderSecrets(secrets []presenters.Secret) error {
+	table := rt.newTable([]string{"Name", "Created At"})
+	for _, s := range secrets {
+		table.Append([]string{
+			s.Name,
+			utils.ISO8601UTC(s.CreatedAt),
+		})
+	}
+
+	render("Secrets", table)
+	return nil
+}
+
 func (rt RendererTable) renderAccountBalances(balances []presenters.AccountBalance) error {
 	table := rt.newTable([]string{"Address", "ETH", "LINK"})
 	for _, ab := range balances {
@@ -219,6 +262,20 @@ func (rt RendererTable) renderAccountBalances(balances []presenters.AccountBalan
 	return nil
 }
 
+func (rt RendererTable) renderKeys(keys []presenters.Key) error {
+	table := rt.newTable([]string{"Address", "ETH", "LINK", "Send Disabled"})
+	for _, k := range keys {
+		table.Append([]string{
+			k.Address,
+			k.EthBalance.String(),
+			k.LinkBalance.String(),
+			fmt.Sprint(k.SendDisabled),
+		})
+	}
+	render("Keys", table)
+	return nil
+}
+
 func (rt RendererTable) renderServiceAgreement(sa presenters.ServiceAgreement) error {
 	table := rt.newTable([]string{"ID", "Created At", "Payment", "Expiration"})
 	table.Append([]string{
@@ -252,3 +309,21 @@ func (rt RendererTable) renderTxAttempts(attempts []models.TxAttempt) error {
 	render("Tx Attempts", table)
 	return nil
 }
+
+func (rt RendererTable) renderTxs(txs []presenters.Tx) error {
+	table := rt.newTable([]string{"ID", "From", "Nonce", "Hash", "GasPrice", "SentAt", "Confirmed"})
+	for _, tx := range txs {
+		table.Append([]string{
+			fmt.Sprint(tx.ID),
+			tx.From.Hex(),
+			fmt.Sprint(tx.Nonce),
+			tx.Hash.Hex(),
+			fmt.Sprint(tx.GasPrice),
+			fmt.Sprint(tx.SentAt),
+			fmt.Sprint(tx.Confirmed),
+		})
+	}
+
+	render("Transactions", table)
+	return nil
+}
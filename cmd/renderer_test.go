@@ -10,6 +10,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/cmd"
 	"github.com/smartcontractkit/chainlink/internal/cltest"
+	"github.com/smartcontractkit/chainlink/store/assets"
 	"github.com/smartcontractkit/chainlink/store/models"
 	"github.com/smartcontractkit/chainlink/store/presenters"
 	"github.com/stretchr/testify/assert"
@@ -156,6 +157,56 @@ func TestRendererTable_Render_TxAttempts(t *testing.T) {
 	assert.Contains(t, output, fmt.Sprint(attempts[0].Confirmed))
 }
 
+func TestRendererTable_Render_Keys(t *testing.T) {
+	t.Parallel()
+
+	keys := []presenters.Key{
+		presenters.Key{
+			AccountBalance: presenters.AccountBalance{
+				Address:     cltest.NewAddress().Hex(),
+				EthBalance:  assets.NewEth(1),
+				LinkBalance: assets.NewLink(2),
+			},
+			SendDisabled: true,
+		},
+	}
+
+	buffer := bytes.NewBufferString("")
+	r := cmd.RendererTable{Writer: buffer}
+
+	assert.NoError(t, r.Render(&keys))
+	output := buffer.String()
+	assert.Contains(t, output, keys[0].Address)
+	assert.Contains(t, output, keys[0].EthBalance.String())
+	assert.Contains(t, output, keys[0].LinkBalance.String())
+	assert.Contains(t, output, "true")
+}
+
+func TestRendererTable_Render_Txs(t *testing.T) {
+	t.Parallel()
+
+	txs := []presenters.Tx{
+		presenters.Tx{
+			ID:       1,
+			From:     cltest.NewAddress(),
+			Nonce:    7,
+			Hash:     cltest.NewHash(),
+			GasPrice: big.NewInt(20),
+			SentAt:   100,
+		},
+	}
+
+	buffer := bytes.NewBufferString("")
+	r := cmd.RendererTable{Writer: buffer}
+
+	assert.NoError(t, r.Render(&txs))
+	output := buffer.String()
+	assert.Contains(t, output, txs[0].From.Hex())
+	assert.Contains(t, output, txs[0].Hash.Hex())
+	assert.Contains(t, output, fmt.Sprint(txs[0].GasPrice))
+	assert.Contains(t, output, fmt.Sprint(txs[0].SentAt))
+}
+
 func TestRendererTable_ServiceAgreementShow(t *testing.T) {
 	t.Parallel()
 
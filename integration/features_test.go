@@ -8,6 +8,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -141,6 +142,63 @@ func TestIntegration_RunAt(t *testing.T) {
 	cltest.WaitForRuns(t, j, app.Store, 1)
 }
 
+func TestIntegration_RunCompletion(t *testing.T) {
+	t.Parallel()
+	app, cleanup := cltest.NewApplication()
+	defer cleanup()
+	app.Start()
+
+	parent, _ := cltest.NewJobWithWebInitiator()
+	parent = cltest.CreateJobSpecViaWeb(t, app, parent)
+
+	child := cltest.NewJob()
+	child.Initiators = []models.Initiator{{
+		Type:            models.InitiatorRunCompletion,
+		InitiatorParams: models.InitiatorParams{ParentJobID: parent.ID},
+	}}
+	child = cltest.CreateJobSpecViaWeb(t, app, child)
+
+	parentRun := cltest.CreateJobRunViaWeb(t, app, parent, `{"result":"100"}`)
+	cltest.WaitForJobRunToComplete(t, app.Store, parentRun)
+
+	childRuns := cltest.WaitForRuns(t, child, app.Store, 1)
+	childRun := cltest.WaitForJobRunToComplete(t, app.Store, childRuns[0])
+	assert.Equal(t, parentRun.ID, childRun.ParentRunID)
+	assert.Equal(t, "100", childRun.Result.Data.Get("result").String())
+
+	gomega.NewGomegaWithT(t).Eventually(func() []string {
+		parentRun, err := app.Store.FindJobRun(parentRun.ID)
+		assert.NoError(t, err)
+		return parentRun.ChildRunIDs
+	}).Should(gomega.Equal([]string{childRun.ID}))
+}
+
+func TestIntegration_TaskGraph(t *testing.T) {
+	t.Parallel()
+	app, cleanup := cltest.NewApplication()
+	defer cleanup()
+	app.Start()
+
+	j, _ := cltest.NewJobWithWebInitiator()
+	first := cltest.NewTask("noop")
+	first.ID = "first"
+	second := cltest.NewTask("noop")
+	second.ID = "second"
+	join := cltest.NewTask("noop")
+	join.DependsOn = []string{"first", "second"}
+	j.Tasks = []models.TaskSpec{first, second, join}
+	j = cltest.CreateJobSpecViaWeb(t, app, j)
+
+	jr := cltest.CreateJobRunViaWeb(t, app, j, `{"result":"100"}`)
+	jr = cltest.WaitForJobRunToComplete(t, app.Store, jr)
+
+	assert.Equal(t, "100", jr.Result.Data.Get("first.result").String())
+	assert.Equal(t, "100", jr.Result.Data.Get("second.result").String())
+	for _, tr := range jr.TaskRuns {
+		assert.Equal(t, models.RunStatusCompleted, tr.Status)
+	}
+}
+
 func TestIntegration_EthLog(t *testing.T) {
 	t.Parallel()
 	app, cleanup := cltest.NewApplication()
@@ -351,6 +409,75 @@ func TestIntegration_ExternalAdapter_Copy(t *testing.T) {
 	assert.Equal(t, eaQuote, res.String())
 }
 
+func TestIntegration_ExternalAdapter_Retries(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplication()
+	defer cleanup()
+	app.Start()
+
+	var requests int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&requests, 1) == 1 {
+			w.WriteHeader(503)
+			io.WriteString(w, "upstream unavailable")
+			return
+		}
+		w.WriteHeader(200)
+		io.WriteString(w, `{"data":{"value":"1234"}}`)
+	}))
+	defer ts.Close()
+
+	bridgeJSON := fmt.Sprintf(`{"name":"flaky","url":"%v"}`, ts.URL)
+	cltest.CreateBridgeTypeViaWeb(t, app, bridgeJSON)
+
+	j, _ := cltest.NewJobWithWebInitiator()
+	task := cltest.NewTask("flaky")
+	task.Retries = 2
+	task.RetryOn = []string{"503"}
+	j.Tasks = []models.TaskSpec{task}
+	j = cltest.CreateJobSpecViaWeb(t, app, j)
+
+	jr := cltest.WaitForJobRunToComplete(t, app.Store, cltest.CreateJobRunViaWeb(t, app, j))
+
+	tr := jr.TaskRuns[0]
+	val, err := tr.Result.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "1234", val)
+	require.Len(t, tr.Attempts, 2)
+	assert.Equal(t, models.RunStatusErrored, tr.Attempts[0].Status)
+	assert.Contains(t, tr.Attempts[0].ErrorMessage.String, "503")
+	assert.Equal(t, models.RunStatusCompleted, tr.Attempts[1].Status)
+}
+
+func TestIntegration_ExternalAdapter_Timeout(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplication()
+	defer cleanup()
+	app.Start()
+
+	done := make(chan struct{})
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-done
+	}))
+	defer ts.Close()
+	defer close(done)
+
+	bridgeJSON := fmt.Sprintf(`{"name":"hanging","url":"%v"}`, ts.URL)
+	cltest.CreateBridgeTypeViaWeb(t, app, bridgeJSON)
+
+	j, _ := cltest.NewJobWithWebInitiator()
+	task := cltest.NewTask("hanging")
+	task.Timeout = models.Duration(100 * time.Millisecond)
+	j.Tasks = []models.TaskSpec{task}
+	j = cltest.CreateJobSpecViaWeb(t, app, j)
+
+	jr := cltest.CreateJobRunViaWeb(t, app, j)
+	jr = cltest.WaitForJobRunStatus(t, app.Store, jr, models.RunStatusErrored)
+	assert.Contains(t, jr.Result.Error(), "task timed out after 100ms")
+}
+
 // This test ensures that an bridge adapter task is resumed from pending after
 // sending out a request to an external adapter and waiting to receive a
 // request back
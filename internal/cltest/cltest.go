@@ -99,7 +99,9 @@ func NewConfigWithWSServer(wsserver *httptest.Server) *TestConfig {
 	rawConfig.Set("BRIDGE_RESPONSE_URL", "http://localhost:6688")
 	rawConfig.Set("ETH_CHAIN_ID", 3)
 	rawConfig.Set("CHAINLINK_DEV", true)
+	rawConfig.Set("ETH_BALANCE_MONITOR_BLOCKS", 0)
 	rawConfig.Set("ETH_GAS_BUMP_THRESHOLD", 3)
+	rawConfig.Set("ETH_TX_RECONCILE_PERIOD", "0s")
 	rawConfig.Set("LOG_LEVEL", store.LogLevel{Level: zapcore.DebugLevel})
 	rawConfig.Set("MINIMUM_SERVICE_DURATION", "24h")
 	rawConfig.Set("MIN_OUTGOING_CONFIRMATIONS", 6)
@@ -440,8 +442,8 @@ func (r *HTTPClientCleaner) Patch(path string, body io.Reader, headers ...map[st
 	return bodyCleaner(r.HTTPClient.Patch(path, body, headers...))
 }
 
-func (r *HTTPClientCleaner) Delete(path string) (*http.Response, func()) {
-	return bodyCleaner(r.HTTPClient.Delete(path))
+func (r *HTTPClientCleaner) Delete(path string, body ...io.Reader) (*http.Response, func()) {
+	return bodyCleaner(r.HTTPClient.Delete(path, body...))
 }
 
 func bodyCleaner(resp *http.Response, err error) (*http.Response, func()) {
@@ -330,9 +330,6 @@ func StringToVersionedLogData20190123(internalID, str string) []byte {
 	version := utils.EVMWordUint64(1)
 	buf.Write(version)
 
-	dataLocation := utils.EVMWordUint64(common.HashLength * 6)
-	buf.Write(dataLocation)
-
 	callbackAddr := utils.EVMWordUint64(0)
 	buf.Write(callbackAddr)
 
@@ -342,6 +339,9 @@ func StringToVersionedLogData20190123(internalID, str string) []byte {
 	expiration := utils.EVMWordUint64(4000000000)
 	buf.Write(expiration)
 
+	dataLocation := utils.EVMWordUint64(common.HashLength * 6)
+	buf.Write(dataLocation)
+
 	cbor, err := JSONFromString(str).CBOR()
 	mustNotErr(err)
 	buf.Write(utils.EVMWordUint64(uint64(len(cbor))))
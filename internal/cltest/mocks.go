@@ -16,6 +16,7 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/mrwonko/cron"
 	"github.com/onsi/gomega"
 	"github.com/smartcontractkit/chainlink/cmd"
 	"github.com/smartcontractkit/chainlink/logger"
@@ -102,6 +103,22 @@ func (mock *EthMock) RegisterError(method, errMsg string) {
 	mock.Responses = append(mock.Responses, res)
 }
 
+// RegisterRPCError registers an error response from the ethereum node, such
+// as a rejected transaction, as opposed to an error reaching the node.
+func (mock *EthMock) RegisterRPCError(method string, code int, errMsg string) {
+	res := MockResponse{
+		methodName: method,
+		errMsg:     errMsg,
+		errCode:    code,
+		hasError:   true,
+		context:    mock.context,
+	}
+
+	mock.mutex.Lock()
+	defer mock.mutex.Unlock()
+	mock.Responses = append(mock.Responses, res)
+}
+
 // AllCalled return true if all mocks have been mocked
 func (mock *EthMock) AllCalled() bool {
 	mock.mutex.RLock()
@@ -124,7 +141,9 @@ func (mock *EthMock) Call(result interface{}, method string, args ...interface{}
 	for i, resp := range mock.Responses {
 		if resp.methodName == method {
 			mock.Responses = append(mock.Responses[:i], mock.Responses[i+1:]...)
-			if resp.hasError {
+			if resp.hasError && resp.errCode != 0 {
+				return mockRPCError{code: resp.errCode, message: resp.errMsg}
+			} else if resp.hasError {
 				return fmt.Errorf(resp.errMsg)
 			}
 			ref := reflect.ValueOf(result)
@@ -166,6 +185,8 @@ func channelFromSubscriptionName(name string) interface{} {
 		return make(chan models.Log)
 	case "newHeads":
 		return make(chan models.BlockHeader)
+	case "newPendingTransactions":
+		return make(chan models.PendingTransaction)
 	default:
 		return make(chan struct{})
 	}
@@ -187,6 +208,8 @@ func (mock *EthMock) EthSubscribe(
 				fwdLogs(channel, sub.channel)
 			case chan<- models.BlockHeader:
 				fwdHeaders(channel, sub.channel)
+			case chan<- models.PendingTransaction:
+				fwdPendingTransactions(channel, sub.channel)
 			default:
 				return nil, errors.New("Channel type not supported by ethMock")
 			}
@@ -246,6 +269,16 @@ func fwdHeaders(actual, mock interface{}) {
 	}()
 }
 
+func fwdPendingTransactions(actual, mock interface{}) {
+	txChan := actual.(chan<- models.PendingTransaction)
+	mockChan := mock.(chan models.PendingTransaction)
+	go func() {
+		for e := range mockChan {
+			txChan <- e
+		}
+	}()
+}
+
 // MockSubscription a mock subscription
 type MockSubscription struct {
 	name    string
@@ -282,10 +315,21 @@ type MockResponse struct {
 	context    string
 	response   interface{}
 	errMsg     string
+	errCode    int
 	hasError   bool
 	callback   func(interface{}, ...interface{}) error
 }
 
+// mockRPCError is an error returned by the ethereum node itself, rather than
+// one reaching it.
+type mockRPCError struct {
+	code    int
+	message string
+}
+
+func (e mockRPCError) Error() string  { return e.message }
+func (e mockRPCError) ErrorCode() int { return e.code }
+
 // InstantClock create InstantClock
 func (ta *TestApplication) InstantClock() InstantClock {
 	clock := InstantClock{}
@@ -463,6 +507,7 @@ func NewHTTPMockServer(
 // MockCron represents a mock cron
 type MockCron struct {
 	Entries []MockCronEntry
+	nextID  services.CronEntryID
 }
 
 // NewMockCron returns a new mock cron
@@ -476,13 +521,25 @@ func (*MockCron) Start() {}
 // Stop stops the mockcron
 func (*MockCron) Stop() {}
 
-// AddFunc appends a schedule to mockcron entries
-func (mc *MockCron) AddFunc(schd string, fn func()) error {
+// Schedule appends a schedule to mockcron entries
+func (mc *MockCron) Schedule(schd cron.Schedule, job cron.Job) services.CronEntryID {
+	mc.nextID++
 	mc.Entries = append(mc.Entries, MockCronEntry{
+		ID:       mc.nextID,
 		Schedule: schd,
-		Function: fn,
+		Function: job.Run,
 	})
-	return nil
+	return mc.nextID
+}
+
+// Remove removes the entry with the given ID from mockcron entries
+func (mc *MockCron) Remove(id services.CronEntryID) {
+	for i, entry := range mc.Entries {
+		if entry.ID == id {
+			mc.Entries = append(mc.Entries[:i], mc.Entries[i+1:]...)
+			return
+		}
+	}
 }
 
 // RunEntries run every function for each mockcron entry
@@ -494,7 +551,8 @@ func (mc *MockCron) RunEntries() {
 
 // MockCronEntry a cron schedule and function
 type MockCronEntry struct {
-	Schedule string
+	ID       services.CronEntryID
+	Schedule cron.Schedule
 	Function func()
 }
 
@@ -504,6 +562,8 @@ type MockHeadTrackable struct {
 	ConnectedCallback func(bn *models.IndexableBlockNumber)
 	disconnectedCount int32
 	onNewHeadCount    int32
+	onReorgCount      int32
+	ReorgCallback     func(*models.Reorg)
 }
 
 // Connect increases the connected count by one
@@ -536,6 +596,19 @@ func (m *MockHeadTrackable) OnNewHeadCount() int32 {
 	return atomic.LoadInt32(&m.onNewHeadCount)
 }
 
+// OnReorg increases the OnReorgCount count by one
+func (m *MockHeadTrackable) OnReorg(reorg *models.Reorg) {
+	atomic.AddInt32(&m.onReorgCount, 1)
+	if m.ReorgCallback != nil {
+		m.ReorgCallback(reorg)
+	}
+}
+
+// OnReorgCount returns the count of reorganisations, safely.
+func (m *MockHeadTrackable) OnReorgCount() int32 {
+	return atomic.LoadInt32(&m.onReorgCount)
+}
+
 // NeverSleeper is a struct that never sleeps
 type NeverSleeper struct{}
 
@@ -8,6 +8,7 @@ import (
 	go_ethereum "github.com/ethereum/go-ethereum"
 	accounts "github.com/ethereum/go-ethereum/accounts"
 	common "github.com/ethereum/go-ethereum/common"
+	hexutil "github.com/ethereum/go-ethereum/common/hexutil"
 	gomock "github.com/golang/mock/gomock"
 	store "github.com/smartcontractkit/chainlink/store"
 	assets "github.com/smartcontractkit/chainlink/store/assets"
@@ -39,6 +40,18 @@ func (m *MockTxManager) EXPECT() *MockTxManagerMockRecorder {
 	return m.recorder
 }
 
+// AddAccount mocks base method
+func (m *MockTxManager) AddAccount(arg0 accounts.Account) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "AddAccount", arg0)
+}
+
+// AddAccount indicates an expected call of AddAccount
+func (mr *MockTxManagerMockRecorder) AddAccount(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAccount", reflect.TypeOf((*MockTxManager)(nil).AddAccount), arg0)
+}
+
 // BumpGasUntilSafe mocks base method
 func (m *MockTxManager) BumpGasUntilSafe(arg0 common.Hash) (*store.TxReceipt, error) {
 	m.ctrl.T.Helper()
@@ -54,6 +67,36 @@ func (mr *MockTxManagerMockRecorder) BumpGasUntilSafe(arg0 interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BumpGasUntilSafe", reflect.TypeOf((*MockTxManager)(nil).BumpGasUntilSafe), arg0)
 }
 
+// CancelTx mocks base method
+func (m *MockTxManager) CancelTx(arg0 common.Hash, arg1 *big.Int) (*models.Tx, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelTx", arg0, arg1)
+	ret0, _ := ret[0].(*models.Tx)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelTx indicates an expected call of CancelTx
+func (mr *MockTxManagerMockRecorder) CancelTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelTx", reflect.TypeOf((*MockTxManager)(nil).CancelTx), arg0, arg1)
+}
+
+// CallContract mocks base method
+func (m *MockTxManager) CallContract(arg0 common.Address, arg1 common.Address, arg2 []byte) (hexutil.Bytes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CallContract", arg0, arg1, arg2)
+	ret0, _ := ret[0].(hexutil.Bytes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CallContract indicates an expected call of CallContract
+func (mr *MockTxManagerMockRecorder) CallContract(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CallContract", reflect.TypeOf((*MockTxManager)(nil).CallContract), arg0, arg1, arg2)
+}
+
 // Connect mocks base method
 func (m *MockTxManager) Connect(arg0 *models.IndexableBlockNumber) error {
 	m.ctrl.T.Helper()
@@ -128,18 +171,18 @@ func (mr *MockTxManagerMockRecorder) CreateTxWithEth(arg0, arg1 interface{}) *go
 }
 
 // CreateTxWithGas mocks base method
-func (m *MockTxManager) CreateTxWithGas(arg0 common.Address, arg1 []byte, arg2 *big.Int, arg3 uint64) (*models.Tx, error) {
+func (m *MockTxManager) CreateTxWithGas(arg0 common.Address, arg1 []byte, arg2 *big.Int, arg3, arg4 uint64) (*models.Tx, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateTxWithGas", arg0, arg1, arg2, arg3)
+	ret := m.ctrl.Call(m, "CreateTxWithGas", arg0, arg1, arg2, arg3, arg4)
 	ret0, _ := ret[0].(*models.Tx)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateTxWithGas indicates an expected call of CreateTxWithGas
-func (mr *MockTxManagerMockRecorder) CreateTxWithGas(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+func (mr *MockTxManagerMockRecorder) CreateTxWithGas(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTxWithGas", reflect.TypeOf((*MockTxManager)(nil).CreateTxWithGas), arg0, arg1, arg2, arg3)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTxWithGas", reflect.TypeOf((*MockTxManager)(nil).CreateTxWithGas), arg0, arg1, arg2, arg3, arg4)
 }
 
 // Disconnect mocks base method
@@ -214,6 +257,36 @@ func (mr *MockTxManagerMockRecorder) GetLogs(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLogs", reflect.TypeOf((*MockTxManager)(nil).GetLogs), arg0)
 }
 
+// GetTransactionByHash mocks base method
+func (m *MockTxManager) GetTransactionByHash(arg0 common.Hash) (models.PendingTransaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransactionByHash", arg0)
+	ret0, _ := ret[0].(models.PendingTransaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransactionByHash indicates an expected call of GetTransactionByHash
+func (mr *MockTxManagerMockRecorder) GetTransactionByHash(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransactionByHash", reflect.TypeOf((*MockTxManager)(nil).GetTransactionByHash), arg0)
+}
+
+// GetTxReceipt mocks base method
+func (m *MockTxManager) GetTxReceipt(arg0 common.Hash) (*store.TxReceipt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTxReceipt", arg0)
+	ret0, _ := ret[0].(*store.TxReceipt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTxReceipt indicates an expected call of GetTxReceipt
+func (mr *MockTxManagerMockRecorder) GetTxReceipt(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTxReceipt", reflect.TypeOf((*MockTxManager)(nil).GetTxReceipt), arg0)
+}
+
 // NextActiveAccount mocks base method
 func (m *MockTxManager) NextActiveAccount() *store.ManagedAccount {
 	m.ctrl.T.Helper()
@@ -240,6 +313,18 @@ func (mr *MockTxManagerMockRecorder) OnNewHead(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OnNewHead", reflect.TypeOf((*MockTxManager)(nil).OnNewHead), arg0)
 }
 
+// OnReorg mocks base method
+func (m *MockTxManager) OnReorg(arg0 *models.Reorg) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "OnReorg", arg0)
+}
+
+// OnReorg indicates an expected call of OnReorg
+func (mr *MockTxManagerMockRecorder) OnReorg(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OnReorg", reflect.TypeOf((*MockTxManager)(nil).OnReorg), arg0)
+}
+
 // Register mocks base method
 func (m *MockTxManager) Register(arg0 []accounts.Account) {
 	m.ctrl.T.Helper()
@@ -252,6 +337,49 @@ func (mr *MockTxManagerMockRecorder) Register(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockTxManager)(nil).Register), arg0)
 }
 
+// RemoveAccount mocks base method
+func (m *MockTxManager) RemoveAccount(arg0 common.Address) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveAccount", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveAccount indicates an expected call of RemoveAccount
+func (mr *MockTxManagerMockRecorder) RemoveAccount(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveAccount", reflect.TypeOf((*MockTxManager)(nil).RemoveAccount), arg0)
+}
+
+// SetSendDisabled mocks base method
+func (m *MockTxManager) SetSendDisabled(arg0 common.Address, arg1 bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetSendDisabled", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetSendDisabled indicates an expected call of SetSendDisabled
+func (mr *MockTxManagerMockRecorder) SetSendDisabled(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSendDisabled", reflect.TypeOf((*MockTxManager)(nil).SetSendDisabled), arg0, arg1)
+}
+
+// SpeedUpTx mocks base method
+func (m *MockTxManager) SpeedUpTx(arg0 common.Hash, arg1 *big.Int) (*models.Tx, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SpeedUpTx", arg0, arg1)
+	ret0, _ := ret[0].(*models.Tx)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SpeedUpTx indicates an expected call of SpeedUpTx
+func (mr *MockTxManagerMockRecorder) SpeedUpTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SpeedUpTx", reflect.TypeOf((*MockTxManager)(nil).SpeedUpTx), arg0, arg1)
+}
+
 // SubscribeToLogs mocks base method
 func (m *MockTxManager) SubscribeToLogs(arg0 chan<- models.Log, arg1 go_ethereum.FilterQuery) (models.EthSubscription, error) {
 	m.ctrl.T.Helper()
@@ -282,6 +410,21 @@ func (mr *MockTxManagerMockRecorder) SubscribeToNewHeads(arg0 interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeToNewHeads", reflect.TypeOf((*MockTxManager)(nil).SubscribeToNewHeads), arg0)
 }
 
+// SubscribeToPendingTransactions mocks base method
+func (m *MockTxManager) SubscribeToPendingTransactions(arg0 chan<- models.PendingTransaction) (models.EthSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubscribeToPendingTransactions", arg0)
+	ret0, _ := ret[0].(models.EthSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubscribeToPendingTransactions indicates an expected call of SubscribeToPendingTransactions
+func (mr *MockTxManagerMockRecorder) SubscribeToPendingTransactions(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeToPendingTransactions", reflect.TypeOf((*MockTxManager)(nil).SubscribeToPendingTransactions), arg0)
+}
+
 // WithdrawLINK mocks base method
 func (m *MockTxManager) WithdrawLINK(arg0 models.WithdrawalRequest) (common.Hash, error) {
 	m.ctrl.T.Helper()
@@ -2,6 +2,7 @@ package services
 
 import (
 	"errors"
+	"fmt"
 	"os"
 	"os/signal"
 	"sync"
@@ -23,7 +24,13 @@ type Application interface {
 	GetStore() *store.Store
 	WakeSessionReaper()
 	WakeBulkRunDeleter()
+	WakeBulkLogBackfiller()
+	RunQueueStats() models.RunQueueStats
 	AddJob(job models.JobSpec) error
+	UpdateJob(job *models.JobSpec, jsr models.JobSpecRequest) error
+	ArchiveJob(job *models.JobSpec) error
+	PauseJob(job *models.JobSpec) error
+	ResumeJob(job *models.JobSpec) error
 	AddAdapter(bt *models.BridgeType) error
 	RemoveAdapter(bt *models.BridgeType) error
 	NewBox() packr.Box
@@ -41,9 +48,12 @@ type ChainlinkApplication struct {
 	Store                                             *store.Store
 	SessionReaper                                     SleeperTask
 	BulkRunDeleter                                    SleeperTask
+	BulkLogBackfiller                                 SleeperTask
 	pendingConnectionResumer                          *pendingConnectionResumer
 	bridgeTypeMutex                                   sync.Mutex
 	jobSubscriberID, txManagerID, connectionResumerID string
+	gasPriceEstimatorID, txReconcilerID               string
+	balanceMonitorID                                  string
 }
 
 // NewApplication initializes a new store if one is not already
@@ -61,6 +71,7 @@ func NewApplication(config store.Config) Application {
 		Store:                    store,
 		SessionReaper:            NewStoreReaper(store),
 		BulkRunDeleter:           NewBulkRunDeleter(store),
+		BulkLogBackfiller:        NewBulkLogBackfiller(store),
 		Exiter:                   os.Exit,
 		pendingConnectionResumer: newPendingConnectionResumer(store),
 	}
@@ -83,6 +94,9 @@ func (app *ChainlinkApplication) Start() error {
 	app.txManagerID = app.HeadTracker.Attach(app.Store.TxManager)
 	app.jobSubscriberID = app.HeadTracker.Attach(app.JobSubscriber)
 	app.connectionResumerID = app.HeadTracker.Attach(app.pendingConnectionResumer)
+	app.gasPriceEstimatorID = app.HeadTracker.Attach(app.Store.GasPriceEstimator)
+	app.txReconcilerID = app.HeadTracker.Attach(app.Store.TxReconciler)
+	app.balanceMonitorID = app.HeadTracker.Attach(app.Store.BalanceMonitor)
 
 	return multierr.Combine(
 		app.Store.Start(),
@@ -98,6 +112,7 @@ func (app *ChainlinkApplication) Start() error {
 		app.Scheduler.Start(),
 		app.SessionReaper.Start(),
 		app.BulkRunDeleter.Start(),
+		app.BulkLogBackfiller.Start(),
 	)
 }
 
@@ -113,9 +128,13 @@ func (app *ChainlinkApplication) Stop() error {
 	app.JobRunner.Stop()
 	merr = multierr.Append(merr, app.SessionReaper.Stop())
 	merr = multierr.Append(merr, app.BulkRunDeleter.Stop())
+	merr = multierr.Append(merr, app.BulkLogBackfiller.Stop())
 	app.HeadTracker.Detach(app.jobSubscriberID)
 	app.HeadTracker.Detach(app.txManagerID)
 	app.HeadTracker.Detach(app.connectionResumerID)
+	app.HeadTracker.Detach(app.gasPriceEstimatorID)
+	app.HeadTracker.Detach(app.txReconcilerID)
+	app.HeadTracker.Detach(app.balanceMonitorID)
 	return multierr.Append(merr, app.Store.Close())
 }
 
@@ -134,6 +153,16 @@ func (app *ChainlinkApplication) WakeBulkRunDeleter() {
 	app.BulkRunDeleter.WakeUp()
 }
 
+// WakeBulkLogBackfiller wakes up the backfiller to process log backfill tasks.
+func (app *ChainlinkApplication) WakeBulkLogBackfiller() {
+	app.BulkLogBackfiller.WakeUp()
+}
+
+// RunQueueStats returns the depth and wait times of the job runner's queue.
+func (app *ChainlinkApplication) RunQueueStats() models.RunQueueStats {
+	return app.JobRunner.QueueStats()
+}
+
 // AddJob adds a job to the store and the scheduler. If there was
 // an error from adding the job to the store, the job will not be
 // added to the scheduler.
@@ -143,10 +172,68 @@ func (app *ChainlinkApplication) AddJob(job models.JobSpec) error {
 		return err
 	}
 
+	return app.attachJob(job)
+}
+
+// UpdateJob saves the next version of the job from the passed request,
+// replacing the initiators scheduled and subscribed to for the job.
+func (app *ChainlinkApplication) UpdateJob(job *models.JobSpec, jsr models.JobSpecRequest) error {
+	if job.Archived() {
+		return fmt.Errorf("Cannot update archived job %s", job.ID)
+	}
+
+	next := job.Update(jsr, app.Store.Clock.Now())
+	app.detachJob(job.ID)
+	if err := app.Store.UpdateJob(job, &next); err != nil {
+		return multierr.Append(err, app.attachJob(*job))
+	}
+	*job = next
+	return app.attachJob(*job)
+}
+
+// ArchiveJob stops the job from initiating runs, keeping its run history.
+func (app *ChainlinkApplication) ArchiveJob(job *models.JobSpec) error {
+	if job.Archived() {
+		return fmt.Errorf("Job %s is already archived", job.ID)
+	}
+
+	app.detachJob(job.ID)
+	return app.Store.SetJobStatus(job, models.JobSpecStatusArchived, app.Store.Clock.Now())
+}
+
+// PauseJob stops the job from initiating runs until it is resumed.
+func (app *ChainlinkApplication) PauseJob(job *models.JobSpec) error {
+	if !job.Active() {
+		return fmt.Errorf("Cannot pause job %s with status %s", job.ID, job.Status)
+	}
+
+	app.detachJob(job.ID)
+	return app.Store.SetJobStatus(job, models.JobSpecStatusPaused, app.Store.Clock.Now())
+}
+
+// ResumeJob lets a paused job initiate runs again. Scheduled runs missed
+// while the job was paused are not caught up on.
+func (app *ChainlinkApplication) ResumeJob(job *models.JobSpec) error {
+	if !job.Paused() {
+		return fmt.Errorf("Cannot resume job %s with status %s", job.ID, job.Status)
+	}
+
+	if err := app.Store.SetJobStatus(job, models.JobSpecStatusActive, app.Store.Clock.Now()); err != nil {
+		return err
+	}
+	return app.attachJob(*job)
+}
+
+func (app *ChainlinkApplication) attachJob(job models.JobSpec) error {
 	app.Scheduler.AddJob(job)
 	return app.JobSubscriber.AddJob(job, nil) // nil for latest
 }
 
+func (app *ChainlinkApplication) detachJob(ID string) {
+	app.Scheduler.RemoveJob(ID)
+	app.JobSubscriber.RemoveJob(ID)
+}
+
 // AddAdapter adds an adapter to the store. If another
 // adapter with the same name already exists the adapter
 // will not be added.
@@ -210,6 +297,7 @@ func (c *headTrackableCallback) Connect(*models.IndexableBlockNumber) error {
 
 func (c *headTrackableCallback) Disconnect()                   {}
 func (c *headTrackableCallback) OnNewHead(*models.BlockHeader) {}
+func (c *headTrackableCallback) OnReorg(*models.Reorg)         {}
 
 type pendingConnectionResumer struct {
 	store   *store.Store
@@ -238,3 +326,4 @@ func (p *pendingConnectionResumer) Connect(head *models.IndexableBlockNumber) er
 
 func (p *pendingConnectionResumer) Disconnect()                   {}
 func (p *pendingConnectionResumer) OnNewHead(*models.BlockHeader) {}
+func (p *pendingConnectionResumer) OnReorg(*models.Reorg)         {}
@@ -0,0 +1,137 @@
+package services
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/asdine/storm/q"
+	"github.com/smartcontractkit/chainlink/logger"
+	"github.com/smartcontractkit/chainlink/store"
+	"github.com/smartcontractkit/chainlink/store/models"
+	"github.com/smartcontractkit/chainlink/store/orm"
+)
+
+// backfillPageSize is the number of blocks requested from the ethereum node
+// in a single eth_getLogs call while backfilling.
+const backfillPageSize = 1000
+
+// NewBulkLogBackfiller creates a task runner that is responsible for creating
+// runs for the historical logs of log initiated jobs.
+func NewBulkLogBackfiller(store *store.Store) SleeperTask {
+	return NewSleeperTask(&bulkLogBackfiller{
+		store: store,
+	})
+}
+
+type bulkLogBackfiller struct {
+	store *store.Store
+}
+
+func (blb *bulkLogBackfiller) Work() {
+	tasks := []models.BulkBackfillLogsTask{}
+	query := blb.store.ORM.DB.Select(q.Eq("Status", models.BulkTaskStatusInProgress)).OrderBy("CreatedAt")
+	err := query.Find(&tasks)
+	if err != nil && err != orm.ErrorNotFound {
+		logger.Errorw("Error querying bulk backfill tasks", "error", err)
+		return
+	}
+
+	for i := range tasks {
+		task := &tasks[i]
+		logger.Infow("Processing bulk log backfill task",
+			"task_id", task.ID,
+			"job", task.Query.JobID,
+			"from_block", task.CurrentBlock,
+			"to_block", task.Query.ToBlock,
+		)
+
+		err := RunBackfillTask(blb.store, task)
+		if err != nil {
+			logger.Errorw("Error backfilling logs for bulk task", "task_id", task.ID, "error", err)
+		}
+	}
+}
+
+// RunBackfillTask creates runs for every log in the task's block range that
+// the job has not already run for, recording progress on the task as it goes.
+// Logs which already initiated a run are skipped by their log key, as they
+// are when delivered by a subscription.
+func RunBackfillTask(store *store.Store, task *models.BulkBackfillLogsTask) error {
+	err := backfillLogs(store, task)
+	if err != nil {
+		task.ErrorMessage = err.Error()
+		task.Status = models.BulkTaskStatusErrored
+	} else {
+		task.Status = models.BulkTaskStatusCompleted
+	}
+	return store.ORM.DB.Save(task)
+}
+
+func backfillLogs(store *store.Store, task *models.BulkBackfillLogsTask) error {
+	job, err := store.FindJob(task.Query.JobID)
+	if err != nil {
+		return fmt.Errorf("error finding job %s: %+v", task.Query.JobID, err)
+	}
+
+	initrs := job.InitiatorsFor(
+		models.InitiatorEthLog,
+		models.InitiatorRunLog,
+		models.InitiatorServiceAgreementExecutionLog,
+	)
+	if len(initrs) == 0 {
+		return fmt.Errorf("job %s is not log initiated", job.ID)
+	}
+
+	for task.CurrentBlock <= task.Query.ToBlock {
+		pageEnd := task.CurrentBlock + backfillPageSize - 1
+		if pageEnd > task.Query.ToBlock {
+			pageEnd = task.Query.ToBlock
+		}
+
+		for _, initr := range initrs {
+			created, err := backfillInitiatorLogs(store, job, initr, task.CurrentBlock, pageEnd)
+			task.RunsCreated += created
+			if err != nil {
+				return err
+			}
+		}
+
+		task.CurrentBlock = pageEnd + 1
+		if err := store.ORM.DB.Save(task); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func backfillInitiatorLogs(
+	store *store.Store,
+	job models.JobSpec,
+	initr models.Initiator,
+	fromBlock uint64,
+	toBlock uint64,
+) (int, error) {
+	filter, err := models.FilterQueryFactory(initr, nil)
+	if err != nil {
+		return 0, err
+	}
+	filter.FromBlock = new(big.Int).SetUint64(fromBlock)
+	filter.ToBlock = new(big.Int).SetUint64(toBlock)
+
+	logs, err := store.TxManager.GetLogs(filter)
+	if err != nil {
+		return 0, fmt.Errorf("error fetching logs for blocks %d to %d: %+v", fromBlock, toBlock, err)
+	}
+
+	created := 0
+	for _, log := range logs {
+		le := models.InitiatorLogEvent{JobSpec: job, Initiator: initr, Log: log}.LogRequest()
+		run, err := receiveLogRequest(store, le)
+		if err != nil {
+			logger.Errorw(err.Error(), le.ForLogger()...)
+		} else if run != nil {
+			created++
+		}
+	}
+	return created, nil
+}
@@ -0,0 +1,77 @@
+package services_test
+
+import (
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/internal/cltest"
+	"github.com/smartcontractkit/chainlink/services"
+	"github.com/smartcontractkit/chainlink/store/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunBackfillTask(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+	eth := cltest.MockEthOnStore(store)
+
+	job, initr := cltest.NewJobWithLogInitiator()
+	require.NoError(t, store.SaveJob(&job))
+
+	seenLog := cltest.LogFromFixture("../internal/fixtures/eth/subscription_logs.json")
+	seenLog.Address = initr.Address
+	newLog := seenLog
+	newLog.Index = seenLog.Index + 1
+
+	seenRun := job.NewRun(initr)
+	seenRun.LogKey = models.LogKey(models.InitiatorLogEvent{JobSpec: job, Initiator: initr, Log: seenLog}.LogRequest())
+	require.NoError(t, store.SaveJobRun(&seenRun))
+
+	eth.Register("eth_getLogs", []models.Log{seenLog, newLog})
+
+	task, err := models.NewBulkBackfillLogsTask(models.BackfillLogsRequest{
+		JobID:     job.ID,
+		FromBlock: 1,
+		ToBlock:   10,
+	})
+	require.NoError(t, err)
+	require.NoError(t, store.ORM.DB.Save(task))
+
+	require.NoError(t, services.RunBackfillTask(store, task))
+	eth.EventuallyAllCalled(t)
+
+	assert.Equal(t, models.BulkTaskStatusCompleted, task.Status)
+	assert.Equal(t, 1, task.RunsCreated)
+	assert.Equal(t, uint64(11), task.CurrentBlock)
+
+	runs, err := store.JobRunsFor(job.ID)
+	require.NoError(t, err)
+	require.Len(t, runs, 2)
+
+	newKey := models.LogKey(models.InitiatorLogEvent{JobSpec: job, Initiator: initr, Log: newLog}.LogRequest())
+	_, err = store.FindJobRunByLogKey(newKey)
+	assert.NoError(t, err)
+}
+
+func TestRunBackfillTask_NotLogInitiated(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	job, _ := cltest.NewJobWithWebInitiator()
+	require.NoError(t, store.SaveJob(&job))
+
+	task, err := models.NewBulkBackfillLogsTask(models.BackfillLogsRequest{
+		JobID:     job.ID,
+		FromBlock: 1,
+		ToBlock:   10,
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, services.RunBackfillTask(store, task))
+	assert.Equal(t, models.BulkTaskStatusErrored, task.Status)
+	assert.Contains(t, task.ErrorMessage, "not log initiated")
+}
@@ -10,7 +10,7 @@ func ExportedExecuteRunAtBlock(
 	store *store.Store,
 	input models.RunResult,
 ) (*models.JobRun, error) {
-	return executeRun(run, store)
+	return executeRun(run, store, nil)
 }
 
 func ExportedChannelForRun(jr JobRunner, runID string) chan<- struct{} {
@@ -34,3 +34,43 @@ func ExportedNewPendingConnectionResumer(
 		resumer: resumer,
 	}
 }
+
+type ExportedRunQueue struct {
+	rq *runQueue
+}
+
+func NewExportedRunQueue(store *store.Store) ExportedRunQueue {
+	return ExportedRunQueue{newRunQueue(store)}
+}
+
+func (q ExportedRunQueue) Restore() error {
+	return q.rq.restore()
+}
+
+func (q ExportedRunQueue) Acquire(run models.JobRun, done <-chan struct{}) bool {
+	return q.rq.acquire(run, done)
+}
+
+func (q ExportedRunQueue) TryAcquire(run models.JobRun) bool {
+	return q.rq.tryAcquire(run)
+}
+
+func (q ExportedRunQueue) Release(jobID string) {
+	q.rq.release(jobID)
+}
+
+func (q ExportedRunQueue) Stats() models.RunQueueStats {
+	return q.rq.stats()
+}
+
+func ExportedProcessHead(ht *HeadTracker, header models.BlockHeader) (*models.Reorg, error) {
+	return ht.processHead(header)
+}
+
+func ExportedSaveAndTrigger(run *models.JobRun, store *store.Store) error {
+	return saveAndTrigger(run, store)
+}
+
+func ExportedNewChainlinkCron() Cron {
+	return newChainlinkCron()
+}
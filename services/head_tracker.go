@@ -3,14 +3,17 @@ package services
 import (
 	"errors"
 	"fmt"
+	"math/big"
 	"sync"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	uuid "github.com/satori/go.uuid"
 	"github.com/smartcontractkit/chainlink/logger"
 	"github.com/smartcontractkit/chainlink/store"
 	strpkg "github.com/smartcontractkit/chainlink/store"
 	"github.com/smartcontractkit/chainlink/store/models"
+	"github.com/smartcontractkit/chainlink/store/orm"
 	"github.com/smartcontractkit/chainlink/store/presenters"
 	"github.com/smartcontractkit/chainlink/utils"
 	"github.com/tevino/abool"
@@ -156,6 +159,12 @@ func (ht *HeadTracker) onNewHead(head *models.BlockHeader) {
 	})
 }
 
+func (ht *HeadTracker) onReorg(reorg *models.Reorg) {
+	ht.attachments.iter(func(t store.HeadTrackable) {
+		t.OnReorg(reorg)
+	})
+}
+
 func (ht *HeadTracker) listenForNewHeads() {
 	defer ht.listenForNewHeadsWg.Done()
 	defer ht.unsubscribeFromHead()
@@ -179,16 +188,17 @@ func (ht *HeadTracker) subscribe() bool {
 	ht.sleeper.Reset()
 	for {
 		ht.unsubscribeFromHead()
-		logger.Info("Connecting to node ", ht.store.Config.EthereumURL(), " in ", ht.sleeper.Duration())
+		url := ht.store.EthNodes.ActiveURL()
+		logger.Info("Connecting to node ", url, " in ", ht.sleeper.Duration())
 		select {
 		case <-ht.done:
 			return false
 		case <-time.After(ht.sleeper.After()):
 			err := ht.subscribeToHead()
 			if err != nil {
-				logger.Warnw(fmt.Sprintf("Failed to connect to %v", ht.store.Config.EthereumURL()), "err", err)
+				logger.Warnw(fmt.Sprintf("Failed to connect to %v", url), "err", err)
 			} else {
-				logger.Info("Connected to node ", ht.store.Config.EthereumURL())
+				logger.Info("Connected to node ", url)
 				ht.fastForwardHeadFromEth()
 				return true
 			}
@@ -207,9 +217,12 @@ func (ht *HeadTracker) receiveHeaders() error {
 			}
 			number := header.ToIndexableBlockNumber()
 			logger.Debugw(fmt.Sprintf("Received header %v with hash %s", presenters.FriendlyBigInt(number.ToInt()), header.Hash().String()), "hash", header.Hash())
-			if err := ht.Save(number); err != nil {
+			if reorg, err := ht.processHead(header); err != nil {
 				logger.Error(err.Error())
 			} else {
+				if reorg != nil {
+					ht.onReorg(reorg)
+				}
 				ht.onNewHead(&header)
 			}
 		case err, open := <-ht.headSubscription.Err():
@@ -255,8 +268,139 @@ func (ht *HeadTracker) fastForwardHeadFromEth() {
 	bn := header.ToIndexableBlockNumber()
 	if bn.GreaterThan(ht.Head()) {
 		logger.Debug("Fast forwarding to block header ", presenters.FriendlyBigInt(bn.ToInt()))
-		logger.WarnIf(ht.Save(bn))
 	}
+	reorg, err := ht.processHead(header)
+	if err != nil {
+		logger.Warn(err.Error())
+	} else if reorg != nil {
+		ht.onReorg(reorg)
+	}
+}
+
+// processHead saves the header among the recent heads, returning the chain
+// reorganisation it reveals, if any.
+func (ht *HeadTracker) processHead(header models.BlockHeader) (*models.Reorg, error) {
+	head := models.NewHead(header)
+	forked, err := ht.forks(head)
+	if err != nil {
+		return nil, err
+	}
+	if forked {
+		return ht.reorganise(head)
+	}
+
+	if err := ht.saveRecentHead(head); err != nil {
+		return nil, err
+	}
+	return nil, ht.Save(header.ToIndexableBlockNumber())
+}
+
+// forks returns true if the head does not extend the chain of recent heads,
+// either because another block is kept at its height or because its parent
+// is not the block kept below it.
+func (ht *HeadTracker) forks(head models.Head) (bool, error) {
+	existing, err := ht.store.FindRecentHead(head.Number)
+	if err == nil {
+		return existing.Hash != head.Hash, nil
+	} else if err != orm.ErrorNotFound {
+		return false, err
+	}
+
+	if head.Number == 0 {
+		return false, nil
+	}
+	parent, err := ht.store.FindRecentHead(head.Number - 1)
+	if err == orm.ErrorNotFound {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	return parent.Hash != head.ParentHash, nil
+}
+
+// reorganise walks the new chain back from the head to the most recent block
+// it shares with the recent heads, and replaces the orphaned heads with those
+// of the new chain.
+func (ht *HeadTracker) reorganise(head models.Head) (*models.Reorg, error) {
+	chain := []models.Head{head}
+	cursor := head
+	var ancestor *models.IndexableBlockNumber
+	for {
+		if cursor.Number == 0 {
+			return nil, errors.New("HeadTracker: chain reorganisation replaced the genesis block")
+		}
+
+		parent, err := ht.store.FindRecentHead(cursor.Number - 1)
+		if err == orm.ErrorNotFound {
+			// The fork is older than the recent heads kept, so the deepest
+			// block that can be compared is treated as the common ancestor.
+			ancestor = models.NewIndexableBlockNumber(new(big.Int).SetUint64(cursor.Number-1), cursor.ParentHash)
+			break
+		} else if err != nil {
+			return nil, err
+		}
+		if parent.Hash == cursor.ParentHash {
+			ancestor = parent.ToIndexableBlockNumber()
+			break
+		}
+
+		header, err := ht.store.TxManager.GetBlockByNumber(hexutil.EncodeUint64(cursor.Number - 1))
+		if err != nil {
+			return nil, err
+		}
+		cursor = models.NewHead(header)
+		chain = append(chain, cursor)
+	}
+
+	if err := ht.store.DeleteRecentHeadsAfter(ancestor.ToInt().Uint64()); err != nil {
+		return nil, err
+	}
+	for i := len(chain) - 1; i >= 0; i-- {
+		if err := ht.saveRecentHead(chain[i]); err != nil {
+			return nil, err
+		}
+	}
+	if err := ht.store.DeleteHeadsAfter(ancestor); err != nil {
+		return nil, err
+	}
+
+	newHead := head.ToIndexableBlockNumber()
+	if err := ht.store.SaveHead(newHead); err != nil {
+		return nil, err
+	}
+	ht.headMutex.Lock()
+	oldHead := ht.head
+	ht.head = newHead
+	ht.headMutex.Unlock()
+
+	reorg := &models.Reorg{
+		CommonAncestor: ancestor,
+		OldHead:        oldHead,
+		NewHead:        newHead,
+	}
+	if oldHead.GreaterThan(ancestor) {
+		reorg.Depth = new(big.Int).Sub(oldHead.ToInt(), ancestor.ToInt()).Uint64()
+	}
+	logger.Warnw(
+		fmt.Sprintf("Chain reorganisation of depth %v detected at block %v", reorg.Depth, presenters.FriendlyBigInt(newHead.ToInt())),
+		"commonAncestor", ancestor.String(),
+		"commonAncestorHash", ancestor.Hash.String(),
+		"newHeadHash", newHead.Hash.String(),
+		"depth", reorg.Depth,
+	)
+	return reorg, nil
+}
+
+// saveRecentHead saves the head and prunes the recent heads that fell out of
+// the configured window.
+func (ht *HeadTracker) saveRecentHead(head models.Head) error {
+	if err := ht.store.SaveRecentHead(&head); err != nil {
+		return err
+	}
+	if depth := ht.store.Config.HeadHistoryDepth(); head.Number > depth {
+		return ht.store.PruneRecentHeads(head.Number - depth)
+	}
+	return nil
 }
 
 func (ht *HeadTracker) updateHeadFromDb() error {
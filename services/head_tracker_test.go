@@ -3,9 +3,11 @@ package services_test
 import (
 	"errors"
 	"math/big"
+	"sync"
 	"sync/atomic"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/onsi/gomega"
 	"github.com/smartcontractkit/chainlink/internal/cltest"
@@ -215,3 +217,86 @@ func TestHeadTracker_StartConnectsFromLastSavedHeader(t *testing.T) {
 	assert.Equal(t, currentBN, ht.Head().ToInt())
 	assert.NoError(t, ht.Stop())
 }
+
+func TestHeadTracker_DetectsReorg(t *testing.T) {
+	t.Parallel()
+	g := gomega.NewGomegaWithT(t)
+
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+	eth := cltest.MockEthOnStore(store)
+	ht := services.NewHeadTracker(store, cltest.NeverSleeper{})
+
+	var reorgs []*models.Reorg
+	var reorgsMutex sync.Mutex
+	checker := &cltest.MockHeadTrackable{ReorgCallback: func(reorg *models.Reorg) {
+		reorgsMutex.Lock()
+		defer reorgsMutex.Unlock()
+		reorgs = append(reorgs, reorg)
+	}}
+	ht.Attach(checker)
+
+	headers := make(chan models.BlockHeader)
+	eth.RegisterSubscription("newHeads", headers)
+	require.NoError(t, ht.Start())
+	defer ht.Stop()
+	g.Eventually(func() int32 { return checker.ConnectedCount() }).Should(gomega.Equal(int32(1)))
+
+	header := func(number int, hash, parent common.Hash) models.BlockHeader {
+		return models.BlockHeader{Number: cltest.BigHexInt(number), GethHash: hash, ParentHash: parent}
+	}
+	a1, a2, a3 := cltest.NewHash(), cltest.NewHash(), cltest.NewHash()
+	b2, b3 := cltest.NewHash(), cltest.NewHash()
+
+	headers <- header(1, a1, cltest.NewHash())
+	headers <- header(2, a2, a1)
+	headers <- header(3, a3, a2)
+	g.Eventually(func() int32 { return checker.OnNewHeadCount() }).Should(gomega.Equal(int32(3)))
+	assert.Equal(t, int32(0), checker.OnReorgCount())
+
+	eth.Register("eth_getBlockByNumber", header(2, b2, a1))
+	headers <- header(3, b3, b2)
+	g.Eventually(func() int32 { return checker.OnReorgCount() }).Should(gomega.Equal(int32(1)))
+	g.Eventually(func() int32 { return checker.OnNewHeadCount() }).Should(gomega.Equal(int32(4)))
+
+	reorgsMutex.Lock()
+	reorg := reorgs[0]
+	reorgsMutex.Unlock()
+	assert.Equal(t, uint64(2), reorg.Depth)
+	assert.Equal(t, a1, reorg.CommonAncestor.Hash)
+	assert.Equal(t, a3, reorg.OldHead.Hash)
+	assert.Equal(t, b3, reorg.NewHead.Hash)
+	assert.Equal(t, b3, ht.Head().Hash)
+
+	recent, err := store.FindRecentHead(2)
+	require.NoError(t, err)
+	assert.Equal(t, b2, recent.Hash)
+	last, err := store.LastHead()
+	require.NoError(t, err)
+	assert.Equal(t, b3, last.Hash)
+}
+
+func TestHeadTracker_PrunesRecentHeads(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+	store.Config.Set("HEAD_HISTORY_DEPTH", 2)
+	ht := services.NewHeadTracker(store, cltest.NeverSleeper{})
+
+	parent := cltest.NewHash()
+	for i := 1; i <= 4; i++ {
+		hash := cltest.NewHash()
+		_, err := services.ExportedProcessHead(ht, models.BlockHeader{Number: cltest.BigHexInt(i), GethHash: hash, ParentHash: parent})
+		require.NoError(t, err)
+		parent = hash
+	}
+
+	_, err := store.FindRecentHead(1)
+	assert.Error(t, err)
+	_, err = store.FindRecentHead(2)
+	assert.NoError(t, err)
+	_, err = store.FindRecentHead(4)
+	assert.NoError(t, err)
+	assert.Equal(t, big.NewInt(4), ht.Head().ToInt())
+}
@@ -2,8 +2,10 @@ package services
 
 import (
 	"errors"
+	"expvar"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/smartcontractkit/chainlink/adapters"
 	"github.com/smartcontractkit/chainlink/logger"
@@ -11,11 +13,16 @@ import (
 	"github.com/smartcontractkit/chainlink/store/models"
 )
 
+// expiredRuns counts the runs whose request expired before they could send
+// their fulfillment, published with the node's other debug vars.
+var expiredRuns = expvar.NewInt("expiredRuns")
+
 // JobRunner safely handles coordinating job runs.
 type JobRunner interface {
 	Start() error
 	Stop()
 	resumeRunsSinceLastShutdown() error
+	QueueStats() models.RunQueueStats
 	channelForRun(string) chan<- struct{}
 	workerCount() int
 }
@@ -25,6 +32,7 @@ type jobRunner struct {
 	done                 chan struct{}
 	bootMutex            sync.Mutex
 	store                *store.Store
+	queue                *runQueue
 	workerMutex          sync.RWMutex
 	workers              map[string]chan struct{}
 	workersWg            sync.WaitGroup
@@ -35,6 +43,7 @@ type jobRunner struct {
 func NewJobRunner(str *store.Store) JobRunner {
 	return &jobRunner{
 		store:   str,
+		queue:   newRunQueue(str),
 		workers: make(map[string]chan struct{}),
 	}
 }
@@ -83,6 +92,10 @@ func (rm *jobRunner) Stop() {
 // ie. tries to run a job.
 // https://github.com/smartcontractkit/chainlink/pull/807
 func (rm *jobRunner) resumeRunsSinceLastShutdown() error {
+	if err := rm.queue.restore(); err != nil {
+		return err
+	}
+
 	sleepingRuns, err := rm.store.JobRunsWithStatus(models.RunStatusPendingSleep)
 	if err != nil {
 		return err
@@ -93,6 +106,16 @@ func (rm *jobRunner) resumeRunsSinceLastShutdown() error {
 		}
 	}
 
+	retryingRuns, err := rm.store.JobRunsWithStatus(models.RunStatusPendingRetry)
+	if err != nil {
+		return err
+	}
+	for _, run := range retryingRuns {
+		if _, err := QueueRetryingTask(&run, rm.store); err != nil {
+			logger.Errorw("Error resuming retrying job", "error", err)
+		}
+	}
+
 	inProgressRuns, err := rm.store.JobRunsWithStatus(models.RunStatusInProgress)
 	if err != nil {
 		return err
@@ -117,7 +140,12 @@ func (rm *jobRunner) demultiplexRuns(starterWg *sync.WaitGroup) {
 				logger.Panic("RunChannel closed before JobRunner, can no longer demultiplexing job runs")
 				return
 			}
-			rm.channelForRun(rr.ID) <- struct{}{}
+			select {
+			case rm.channelForRun(rr.ID) <- struct{}{}:
+			default:
+				// The run's worker has yet to pick up its previous trigger,
+				// which reads the latest state of the run all the same.
+			}
 		}
 	}
 }
@@ -153,15 +181,21 @@ func (rm *jobRunner) workerLoop(runID string, workerChannel chan struct{}) {
 			run, err := rm.store.FindJobRun(runID)
 			if err != nil {
 				logger.Errorw(fmt.Sprint("Error finding run ", runID), run.ForLogger("error", err)...)
+				return
 			}
 
-			if run, err := executeRun(&run, rm.store); err != nil {
-				logger.Errorw(fmt.Sprint("Error executing run ", runID), run.ForLogger("error", err)...)
+			if run.Status.Cancelled() {
+				logger.Debugw("Run cancelled, stopping worker", run.ForLogger()...)
 				return
 			}
 
-			if run.Status.Finished() {
-				logger.Debugw("All tasks complete for run", "run", run.ID)
+			if !rm.queue.acquire(run, rm.done) {
+				logger.Debug("JobRunner worker loop for ", runID, " finished while queued")
+				return
+			}
+			finished := rm.workOnRun(runID)
+			rm.queue.release(run.JobID)
+			if finished {
 				return
 			}
 
@@ -172,6 +206,38 @@ func (rm *jobRunner) workerLoop(runID string, workerChannel chan struct{}) {
 	}
 }
 
+// workOnRun executes the run once it has been given a worker by the run
+// queue, returning true when the run needs no further work.
+func (rm *jobRunner) workOnRun(runID string) bool {
+	// The run may have changed while it was waiting in the queue.
+	run, err := rm.store.FindJobRun(runID)
+	if err != nil {
+		logger.Errorw(fmt.Sprint("Error finding run ", runID), run.ForLogger("error", err)...)
+		return true
+	}
+
+	if run.Status.Cancelled() {
+		logger.Debugw("Run cancelled, stopping worker", run.ForLogger()...)
+		return true
+	}
+
+	if run, err := executeRun(&run, rm.store, rm.queue); err != nil {
+		logger.Errorw(fmt.Sprint("Error executing run ", runID), run.ForLogger("error", err)...)
+		return true
+	}
+
+	if run.Status.Finished() {
+		logger.Debugw("All tasks complete for run", "run", run.ID)
+		return true
+	}
+	return false
+}
+
+// QueueStats returns the depth and wait times of the run queue.
+func (rm *jobRunner) QueueStats() models.RunQueueStats {
+	return rm.queue.stats()
+}
+
 func (rm *jobRunner) workerCount() int {
 	rm.workerMutex.RLock()
 	defer rm.workerMutex.RUnlock()
@@ -181,10 +247,14 @@ func (rm *jobRunner) workerCount() int {
 
 func prepareTaskInput(run *models.JobRun, currentTaskRun *models.TaskRun) (models.RunResult, error) {
 	input := currentTaskRun.Result
-	previousTaskRun := run.PreviousTaskRun()
+	input.TaskRunID = currentTaskRun.ID
 
 	var err error
-	if previousTaskRun != nil {
+	if run.IsGraph() {
+		if input.Data, err = parentsData(run, currentTaskRun); err != nil {
+			return models.RunResult{}, err
+		}
+	} else if previousTaskRun := run.PreviousTaskRun(); previousTaskRun != nil {
 		if input.Data, err = previousTaskRun.Result.Data.Merge(input.Data); err != nil {
 			return models.RunResult{}, err
 		}
@@ -196,17 +266,49 @@ func prepareTaskInput(run *models.JobRun, currentTaskRun *models.TaskRun) (model
 	return input, nil
 }
 
+// parentsData returns the input data of a task in a task graph. A task with a
+// single parent receives its parent's output like in a linear pipeline, while
+// a join task receives the output of each parent under that parent's ID.
+func parentsData(run *models.JobRun, currentTaskRun *models.TaskRun) (models.JSON, error) {
+	data := currentTaskRun.Result.Data
+	parents := run.ParentTaskRuns(*currentTaskRun)
+	if len(parents) == 1 {
+		return parents[0].Result.Data.Merge(data)
+	}
+
+	var err error
+	for _, parent := range parents {
+		if data, err = data.Add(parent.Task.ID, parent.Result.Data); err != nil {
+			return data, err
+		}
+	}
+	return data, nil
+}
+
 func executeTask(run *models.JobRun, currentTaskRun *models.TaskRun, store *store.Store) models.RunResult {
 	var err error
-	if currentTaskRun.Task.Params, err = currentTaskRun.Task.Params.Merge(run.Overrides.Data); err != nil {
+	if currentTaskRun.Task, err = mergeOverrides(run, currentTaskRun.Task); err != nil {
 		return currentTaskRun.Result.WithError(err)
 	}
 
-	adapter, err := adapters.For(currentTaskRun.Task, store)
+	task, secrets, err := resolveTaskSecrets(run, currentTaskRun.Task, store)
 	if err != nil {
 		return currentTaskRun.Result.WithError(err)
 	}
 
+	adapter, err := adapters.For(task, store)
+	if err != nil {
+		return redactResult(currentTaskRun.Result.WithError(err), secrets)
+	}
+
+	if expired, err := requestExpired(run, currentTaskRun, store); err != nil {
+		return redactResult(currentTaskRun.Result.WithError(err), secrets)
+	} else if expired {
+		logger.Warnw("Request expired before fulfillment, skipping transaction", run.ForLogger("expired_at", run.ExpiresAt.Time)...)
+		expiredRuns.Add(1)
+		return currentTaskRun.Result.MarkExpired(models.RequestExpiredError{ExpiresAt: run.ExpiresAt.Time})
+	}
+
 	logger.Infow(fmt.Sprintf("Processing task %s", currentTaskRun.Task.Type), []interface{}{"task", currentTaskRun.ID}...)
 
 	input, err := prepareTaskInput(run, currentTaskRun)
@@ -214,7 +316,13 @@ func executeTask(run *models.JobRun, currentTaskRun *models.TaskRun, store *stor
 		return currentTaskRun.Result.WithError(err)
 	}
 
-	result := adapter.Perform(input, store)
+	timeout := currentTaskRun.Task.Timeout.Duration()
+	if currentTaskRun.Task.Type == adapters.TaskTypeEthTx {
+		// A timed out attempt keeps running and could still send its
+		// transaction, so transactions are never timed out.
+		timeout = 0
+	}
+	result := redactResult(performWithTimeout(adapter, input, timeout, store), secrets)
 
 	logger.Infow(fmt.Sprintf("Finished processing task %s", currentTaskRun.Task.Type), []interface{}{
 		"task", currentTaskRun.ID,
@@ -225,7 +333,62 @@ func executeTask(run *models.JobRun, currentTaskRun *models.TaskRun, store *stor
 	return result
 }
 
-func executeRun(run *models.JobRun, store *store.Store) (*models.JobRun, error) {
+// sendsTx returns true if executing the task run would send a transaction,
+// rather than wait on one it has already sent.
+func sendsTx(taskRun *models.TaskRun) bool {
+	return taskRun.Task.Type == adapters.TaskTypeEthTx && !taskRun.Status.PendingConfirmations()
+}
+
+// requestExpired returns true if executing the task run would send a
+// transaction for a request which expired by the time of the latest block,
+// since the contract checks the expiration against the block time rather
+// than the node's clock. The node's clock is used until a block with a
+// timestamp is known.
+func requestExpired(run *models.JobRun, taskRun *models.TaskRun, store *store.Store) (bool, error) {
+	if !sendsTx(taskRun) || !run.ExpiresAt.Valid {
+		return false, nil
+	}
+	head, err := store.LastRecentHead()
+	if err != nil {
+		return false, err
+	}
+	if head == nil || head.Time == 0 {
+		return run.RequestExpired(store.Clock.Now()), nil
+	}
+	return run.RequestExpired(time.Unix(int64(head.Time), 0)), nil
+}
+
+// performWithTimeout performs the adapter, returning an errored result if it
+// does not finish within the timeout so that a hanging adapter does not hold
+// the run's worker forever. The timed out Perform is not interrupted, so
+// adapters whose side effects must not be repeated are not given a timeout.
+func performWithTimeout(
+	adapter *adapters.PipelineAdapter,
+	input models.RunResult,
+	timeout time.Duration,
+	store *store.Store,
+) models.RunResult {
+	if timeout <= 0 {
+		return adapter.Perform(input, store)
+	}
+
+	results := make(chan models.RunResult, 1)
+	go func() {
+		results <- adapter.Perform(input, store)
+	}()
+
+	select {
+	case result := <-results:
+		return result
+	case <-store.Clock.After(timeout):
+		return input.WithError(models.TaskTimeoutError{Timeout: timeout})
+	}
+}
+
+// executeRun executes the run's next task, or the ready tasks of its task
+// graph, using the free workers of the queue for the graph's other branches.
+// A nil queue executes them one after the other.
+func executeRun(run *models.JobRun, store *store.Store, queue *runQueue) (*models.JobRun, error) {
 	logger.Infow("Processing run", run.ForLogger()...)
 
 	if !run.Status.Runnable() {
@@ -236,14 +399,69 @@ func executeRun(run *models.JobRun, store *store.Store) (*models.JobRun, error)
 		return run, errors.New("Run triggered with no remaining tasks")
 	}
 
-	currentTaskRunIndex, _ := run.NextTaskRunIndex()
-	currentTaskRun := run.TaskRuns[currentTaskRunIndex]
+	if run.Orphaned() {
+		logger.Infow("Run's triggering log was orphaned, waiting for it to be confirmed again", run.ForLogger()...)
+		run.Status = models.RunStatusPendingConfirmations
+		return run, saveAndTrigger(run, store)
+	}
+
+	var currentTaskRun models.TaskRun
+	if run.IsGraph() {
+		if blocked := run.NextTaskRun(); len(run.ReadyTaskRunIndexes()) == 0 && blocked.Status.Pending() {
+			// Another branch of the graph is still waiting on its bridge,
+			// sleep, retry or confirmations, which will trigger the run again.
+			logger.Debugw("Task graph execution blocked", []interface{}{"run", run.ID, "task", blocked.ID, "state", blocked.Status}...)
+			*run = run.ApplyResult(blocked.Result)
+			if err := store.SaveJobRun(run); err != nil || !blocked.Status.PendingRetry() {
+				return run, err
+			}
+			return QueueRetryingTask(run, store)
+		}
+
+		var err error
+		if currentTaskRun, err = executeReadyTasks(run, store, queue); err != nil {
+			return run, err
+		}
+	} else {
+		currentTaskRunIndex, _ := run.NextTaskRunIndex()
+		currentTaskRun = run.TaskRuns[currentTaskRunIndex]
+
+		startedAt := store.Clock.Now()
+		result := executeTask(run, &currentTaskRun, store)
+
+		currentTaskRun = currentTaskRun.ApplyAttempt(result, startedAt, store.Clock.Now())
+		run.TaskRuns[currentTaskRunIndex] = currentTaskRun
+		*run = run.ApplyResult(currentTaskRun.Result)
+	}
+
+	if current, err := store.FindJobRun(run.ID); err == nil && current.Status.Cancelled() {
+		logger.Infow("Run cancelled while executing, discarding task result", run.ForLogger()...)
+		sent := keepSentTxs(run, &current, store.Clock.Now())
+		*run = current
+		if sent {
+			return run, store.SaveJobRun(run)
+		}
+		return run, nil
+	} else if err == nil {
+		// A chain reorganisation may have moved or orphaned the run's
+		// triggering log while it was executing.
+		run.CreationHeight = current.CreationHeight
+		run.OrphanedAt = current.OrphanedAt
+		run.Notes = current.Notes
+	}
 
-	result := executeTask(run, &currentTaskRun, store)
+	if currentTaskRun.Status.Cancelled() {
+		logger.Infow("Task's transaction was cancelled, cancelling run", run.ForLogger()...)
+		*run = run.Cancel()
+	}
 
-	currentTaskRun = currentTaskRun.ApplyResult(result)
-	run.TaskRuns[currentTaskRunIndex] = currentTaskRun
-	*run = run.ApplyResult(result)
+	if currentTaskRun.Status.PendingRetry() {
+		logger.Debugw("Task errored, queueing retry", []interface{}{"run", run.ID, "task", currentTaskRun.ID, "retry_at", currentTaskRun.RetryAt.Time}...)
+		if err := store.SaveJobRun(run); err != nil {
+			return run, err
+		}
+		return QueueRetryingTask(run, store)
+	}
 
 	if currentTaskRun.Status.PendingSleep() {
 		logger.Debugw("Task is sleeping", []interface{}{"run", run.ID}...)
@@ -264,6 +482,92 @@ func executeRun(run *models.JobRun, store *store.Store) (*models.JobRun, error)
 	return run, nil
 }
 
+// keepSentTxs copies the results of the EthTx tasks which sent a transaction
+// while the run was being cancelled onto the cancelled run, noting their
+// transactions since they may still be confirmed. It returns true if there
+// were any.
+func keepSentTxs(executed *models.JobRun, cancelled *models.JobRun, now time.Time) bool {
+	sent := false
+	for i, tr := range executed.TaskRuns {
+		if tr.Task.Type != adapters.TaskTypeEthTx || i >= len(cancelled.TaskRuns) {
+			continue
+		}
+		if before := cancelled.TaskRuns[i].Status; before.PendingConfirmations() || before.Completed() {
+			continue
+		}
+		if tr.Status.PendingConfirmations() || tr.Status.Completed() {
+			cancelled.TaskRuns[i] = tr
+			cancelled.AddNote(now, fmt.Sprintf(
+				"Cancelled while sending transaction %s, which may still be confirmed", tr.Result.Get("value").String()))
+			sent = true
+		}
+	}
+	return sent
+}
+
+// executeReadyTasks executes every task of a task graph whose parents have
+// completed, applying the results to the run. Tasks are executed
+// concurrently on the free workers of the run queue, and the rest one after
+// the other on the run's own worker, so that branches are bounded by
+// MAX_CONCURRENT_RUNS like runs are. It returns the task run that decides how
+// the run proceeds: the first errored or blocked task run, otherwise the last
+// one executed.
+func executeReadyTasks(run *models.JobRun, store *store.Store, queue *runQueue) (models.TaskRun, error) {
+	indexes := run.ReadyTaskRunIndexes()
+	if len(indexes) == 0 {
+		return models.TaskRun{}, errors.New("Run triggered with no tasks ready to execute")
+	}
+
+	startedAt := store.Clock.Now()
+	results := make([]models.RunResult, len(indexes))
+	var wg sync.WaitGroup
+	var inline []int
+	for i, index := range indexes {
+		if i == 0 || queue == nil || !queue.tryAcquire(*run) {
+			inline = append(inline, i)
+			continue
+		}
+		wg.Add(1)
+		go func(i int, taskRun models.TaskRun) {
+			defer wg.Done()
+			defer queue.release(run.JobID)
+			results[i] = executeTask(run, &taskRun, store)
+		}(i, run.TaskRuns[index])
+	}
+	for _, i := range inline {
+		taskRun := run.TaskRuns[indexes[i]]
+		results[i] = executeTask(run, &taskRun, store)
+	}
+	wg.Wait()
+
+	decisive := indexes[0]
+	for i, index := range indexes {
+		run.TaskRuns[index] = run.TaskRuns[index].ApplyAttempt(results[i], startedAt, store.Clock.Now())
+		precedence := statusPrecedence(run.TaskRuns[index].Status)
+		current := statusPrecedence(run.TaskRuns[decisive].Status)
+		if precedence > current || (precedence == 0 && current == 0) {
+			decisive = index
+		}
+	}
+
+	*run = run.ApplyResult(run.TaskRuns[decisive].Result)
+	return run.TaskRuns[decisive], nil
+}
+
+// statusPrecedence ranks errored and expired task runs above retrying ones,
+// retrying task runs above otherwise blocked ones, and blocked task runs
+// above runnable ones.
+func statusPrecedence(status models.RunStatus) int {
+	if status.Errored() || status.Expired() {
+		return 3
+	} else if status.PendingRetry() {
+		return 2
+	} else if !status.Runnable() {
+		return 1
+	}
+	return 0
+}
+
 func queueNextTask(run *models.JobRun, store *store.Store) *models.JobRun {
 	futureTaskRunIndex, _ := run.NextTaskRunIndex()
 	futureTaskRun := run.TaskRuns[futureTaskRunIndex]
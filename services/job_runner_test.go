@@ -1,6 +1,7 @@
 package services_test
 
 import (
+	"expvar"
 	"fmt"
 	"testing"
 	"time"
@@ -8,10 +9,12 @@ import (
 	"github.com/onsi/gomega"
 	"github.com/smartcontractkit/chainlink/internal/cltest"
 	"github.com/smartcontractkit/chainlink/services"
+	strpkg "github.com/smartcontractkit/chainlink/store"
 	"github.com/smartcontractkit/chainlink/store/models"
 	"github.com/smartcontractkit/chainlink/utils"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	null "gopkg.in/guregu/null.v3"
 )
 
 func TestJobRunner_resumeRunsSinceLastShutdown(t *testing.T) {
@@ -143,3 +146,140 @@ func TestJobRunner_Stop(t *testing.T) {
 		return services.ExportedWorkerCount(rm)
 	}).Should(gomega.Equal(0))
 }
+
+func TestJobRunner_executeRun_Orphaned(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	job, initr := cltest.NewJobWithWebInitiator()
+	job.Tasks = []models.TaskSpec{cltest.NewTask("noop"), cltest.NewTask("noop")}
+	require.NoError(t, store.SaveJob(&job))
+	run := job.NewRun(initr)
+	run.Status = models.RunStatusInProgress
+	require.NoError(t, store.SaveJobRun(&run))
+
+	// Orphaned while the first task executes
+	orphaned := run
+	orphaned.OrphanedAt = null.TimeFrom(store.Clock.Now())
+	require.NoError(t, store.SaveJobRun(&orphaned))
+
+	_, err := services.ExportedExecuteRunAtBlock(&run, store, models.RunResult{})
+	require.NoError(t, err)
+
+	run, err = store.FindJobRun(run.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.RunStatusPendingConfirmations, run.Status)
+	assert.True(t, run.Orphaned())
+	assert.Equal(t, models.RunStatusUnstarted, run.TaskRuns[1].Status)
+
+	run.Status = models.RunStatusInProgress
+	_, err = services.ExportedExecuteRunAtBlock(&run, store, models.RunResult{})
+	require.NoError(t, err)
+
+	run, err = store.FindJobRun(run.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.RunStatusPendingConfirmations, run.Status, "orphaned runs wait before executing")
+	assert.Equal(t, models.RunStatusUnstarted, run.TaskRuns[1].Status)
+}
+
+func TestJobRunner_executeRun_RequestExpired(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	job, initr := cltest.NewJobWithWebInitiator()
+	job.Tasks = []models.TaskSpec{cltest.NewTask("ethtx")}
+	require.NoError(t, store.SaveJob(&job))
+	run := job.NewRun(initr)
+	run.Status = models.RunStatusInProgress
+	run.ExpiresAt = null.TimeFrom(store.Clock.Now().Add(-time.Minute))
+	require.NoError(t, store.SaveJobRun(&run))
+
+	expired := expvar.Get("expiredRuns").(*expvar.Int)
+	before := expired.Value()
+
+	_, err := services.ExportedExecuteRunAtBlock(&run, store, models.RunResult{})
+	require.NoError(t, err)
+
+	run, err = store.FindJobRun(run.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.RunStatusExpired, run.Status)
+	assert.Equal(t, models.RunStatusExpired, run.TaskRuns[0].Status)
+	assert.Contains(t, run.Result.Error(), "request expired")
+	assert.True(t, run.Status.Finished())
+	assert.True(t, expired.Value() > before)
+}
+
+func TestJobRunner_executeRun_RequestExpiredByBlockTime(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	job, initr := cltest.NewJobWithWebInitiator()
+	job.Tasks = []models.TaskSpec{cltest.NewTask("ethtx")}
+	require.NoError(t, store.SaveJob(&job))
+	run := job.NewRun(initr)
+	run.Status = models.RunStatusInProgress
+	run.ExpiresAt = null.TimeFrom(store.Clock.Now().Add(time.Minute))
+	require.NoError(t, store.SaveJobRun(&run))
+
+	blockTime := store.Clock.Now().Add(2 * time.Minute).Unix()
+	head := models.Head{Number: 1, Hash: cltest.NewHash(), Time: uint64(blockTime)}
+	require.NoError(t, store.SaveRecentHead(&head))
+
+	_, err := services.ExportedExecuteRunAtBlock(&run, store, models.RunResult{})
+	require.NoError(t, err)
+
+	run, err = store.FindJobRun(run.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.RunStatusExpired, run.Status)
+	assert.Contains(t, run.Result.Error(), "request expired")
+}
+
+func TestJobRunner_executeRun_CancelledWhileSendingTx(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplicationWithKeyStore()
+	defer cleanup()
+	store := app.Store
+
+	ethMock := app.MockEthClient()
+	ethMock.Context("app.StartAndConnect()", func(ethMock *cltest.EthMock) {
+		ethMock.Register("eth_getTransactionCount", utils.Uint64ToHex(0))
+	})
+	require.NoError(t, app.StartAndConnect())
+
+	job, initr := cltest.NewJobWithWebInitiator()
+	job.Tasks = []models.TaskSpec{cltest.NewTask("ethtx", fmt.Sprintf(`{"address":"%s"}`, cltest.NewAddress().Hex()))}
+	require.NoError(t, store.SaveJob(&job))
+	run := job.NewRun(initr)
+	run.Status = models.RunStatusInProgress
+	require.NoError(t, store.SaveJobRun(&run))
+
+	// Cancelled while the transaction is being sent
+	cancelled := run.Cancel()
+	require.NoError(t, store.SaveJobRun(&cancelled))
+
+	hash := cltest.NewHash()
+	ethMock.Context("ethtx.Perform()", func(ethMock *cltest.EthMock) {
+		ethMock.Register("eth_blockNumber", utils.Uint64ToHex(10))
+		ethMock.Register("eth_sendRawTransaction", hash)
+		ethMock.Register("eth_blockNumber", utils.Uint64ToHex(10))
+		ethMock.Register("eth_getTransactionReceipt", strpkg.TxReceipt{})
+	})
+
+	_, err := services.ExportedExecuteRunAtBlock(&run, store, models.RunResult{})
+	require.NoError(t, err)
+	ethMock.EventuallyAllCalled(t)
+
+	run, err = store.FindJobRun(run.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.RunStatusCancelled, run.Status)
+	assert.Equal(t, models.RunStatusPendingConfirmations, run.TaskRuns[0].Status)
+	require.Len(t, run.Notes, 1)
+	assert.Contains(t, run.Notes[0].Text, "may still be confirmed")
+}
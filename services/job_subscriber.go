@@ -1,12 +1,16 @@
 package services
 
 import (
+	"errors"
+	"fmt"
 	"sync"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/smartcontractkit/chainlink/logger"
 	"github.com/smartcontractkit/chainlink/store"
 	"github.com/smartcontractkit/chainlink/store/models"
 	"go.uber.org/multierr"
+	null "gopkg.in/guregu/null.v3"
 )
 
 // JobSubscriber listens for push notifications of event logs from the ethereum
@@ -14,29 +18,36 @@ import (
 type JobSubscriber interface {
 	store.HeadTrackable
 	AddJob(job models.JobSpec, bn *models.IndexableBlockNumber) error
+	RemoveJob(ID string)
 	Jobs() []models.JobSpec
 }
 
+// ErrOrphanedLog is the error of a run whose triggering log was removed from
+// the chain by a reorganisation.
+var ErrOrphanedLog = errors.New("triggering log was orphaned by a chain reorganisation")
+
 // jobSubscriber implementation
 type jobSubscriber struct {
 	store            *store.Store
 	jobSubscriptions []JobSubscription
 	jobsMutex        sync.RWMutex
+	pendingTxs       *PendingTxSubscription
 }
 
 // NewJobSubscriber returns a new job subscriber.
 func NewJobSubscriber(store *store.Store) JobSubscriber {
-	return &jobSubscriber{store: store}
+	return &jobSubscriber{store: store, pendingTxs: NewPendingTxSubscription(store)}
 }
 
 // AddJob subscribes to ethereum log events for each "runlog" and "ethlog"
-// initiator in the passed job spec.
+// initiator, and to pending transactions for each "pendingtx" initiator, in
+// the passed job spec.
 func (js *jobSubscriber) AddJob(job models.JobSpec, bn *models.IndexableBlockNumber) error {
-	if !job.IsLogInitiated() {
+	if !job.Active() || (!job.IsLogInitiated() && !job.IsPendingTxInitiated()) {
 		return nil
 	}
 
-	sub, err := StartJobSubscription(job, bn, js.store)
+	sub, err := StartJobSubscription(job, bn, js.store, js.pendingTxs)
 	if err != nil {
 		return err
 	}
@@ -44,6 +55,21 @@ func (js *jobSubscriber) AddJob(job models.JobSpec, bn *models.IndexableBlockNum
 	return nil
 }
 
+// RemoveJob unsubscribes from the ethereum node on behalf of the job.
+func (js *jobSubscriber) RemoveJob(ID string) {
+	js.jobsMutex.Lock()
+	defer js.jobsMutex.Unlock()
+	remaining := []JobSubscription{}
+	for _, sub := range js.jobSubscriptions {
+		if sub.Job.ID == ID {
+			sub.Unsubscribe()
+		} else {
+			remaining = append(remaining, sub)
+		}
+	}
+	js.jobSubscriptions = remaining
+}
+
 // Jobs returns the jobs being listened to.
 func (js *jobSubscriber) Jobs() []models.JobSpec {
 	js.jobsMutex.RLock()
@@ -83,6 +109,8 @@ func (js *jobSubscriber) Disconnect() {
 }
 
 // OnNewHead resumes all pending job runs based on the new head activity.
+// Runs whose triggering log was orphaned only resume once the log's
+// transaction is included in the new chain.
 func (js *jobSubscriber) OnNewHead(head *models.BlockHeader) {
 	pendingRuns, err := js.store.JobRunsWithStatus(models.RunStatusPendingConfirmations)
 	if err != nil {
@@ -95,9 +123,118 @@ func (js *jobSubscriber) OnNewHead(head *models.BlockHeader) {
 		"pending_run_count", len(pendingRuns),
 	)
 	for _, jr := range pendingRuns {
+		if jr.Orphaned() {
+			if err := recheckOrphanedRun(&jr, js.store); err != nil {
+				logger.Errorw("JobSubscriber.OnNewHead: error re-checking orphaned run", jr.ForLogger("error", err)...)
+				continue
+			} else if jr.Orphaned() || jr.Status.Finished() {
+				continue
+			}
+		}
 		_, err := ResumeConfirmingTask(&jr, js.store, &ibn)
 		if err != nil {
 			logger.Error("JobSubscriber.OnNewHead: ", err.Error())
 		}
 	}
 }
+
+// OnReorg re-validates the unfinished runs whose triggering log was in a block
+// orphaned by the reorganisation. Runs whose log transaction was included in
+// the new chain count their confirmations from its new block. The others are
+// marked orphaned, which holds them in pending_confirmations until the
+// transaction is included again or ORPHANED_RUN_TIMEOUT passes.
+func (js *jobSubscriber) OnReorg(reorg *models.Reorg) {
+	runs, err := js.store.JobRunsWithStatus(unfinishedRunStatuses...)
+	if err != nil {
+		logger.Error("JobSubscriber.OnReorg: error fetching unfinished job runs: ", err.Error())
+		return
+	}
+
+	for _, jr := range runs {
+		if jr.TxHash == nil || jr.CreationHeight == nil || !reorg.Orphaned(jr.CreationHeight.ToInt()) {
+			continue
+		}
+		if err := revalidateOrphanedRun(&jr, reorg, js.store); err != nil {
+			logger.Errorw("JobSubscriber.OnReorg: error re-validating run", jr.ForLogger("error", err)...)
+		}
+	}
+}
+
+var unfinishedRunStatuses = []models.RunStatus{
+	models.RunStatusUnstarted,
+	models.RunStatusInProgress,
+	models.RunStatusPendingConfirmations,
+	models.RunStatusPendingConnection,
+	models.RunStatusPendingBridge,
+	models.RunStatusPendingSleep,
+	models.RunStatusPendingRetry,
+}
+
+// revalidateOrphanedRun records whether the run's triggering log moved to a
+// new block or was dropped from the chain. The run's status is left alone:
+// the job runner holds orphaned runs in pending_confirmations before
+// executing their next task, and OnNewHead resumes or fails them.
+func revalidateOrphanedRun(run *models.JobRun, reorg *models.Reorg, store *store.Store) error {
+	receipt, err := store.TxManager.GetTxReceipt(*run.TxHash)
+	if err != nil {
+		return err
+	}
+
+	now := store.Clock.Now()
+	if receipt.Unconfirmed() {
+		logger.Warnw("Run's triggering log was orphaned by a chain reorganisation", run.ForLogger("depth", reorg.Depth)...)
+		if !run.Orphaned() {
+			run.OrphanedAt = null.TimeFrom(now)
+		}
+		return store.UpdateJobRunOrphaned(run, models.RunNote{
+			CreatedAt: now,
+			Text:      fmt.Sprintf("Triggering log in block %v was orphaned by a chain reorganisation, waiting for it to be included again", run.CreationHeight.ToInt()),
+		})
+	}
+
+	height := hexutil.Big(*receipt.BlockNumber.ToBig())
+	logger.Infow("Run's triggering log moved to a new block by a chain reorganisation", run.ForLogger("new_height", height.ToInt())...)
+	note := models.RunNote{
+		CreatedAt: now,
+		Text:      fmt.Sprintf("Triggering log moved from block %v to block %v by a chain reorganisation", run.CreationHeight.ToInt(), height.ToInt()),
+	}
+	run.CreationHeight = &height
+	run.OrphanedAt = null.Time{}
+	return store.UpdateJobRunOrphaned(run, note)
+}
+
+// recheckOrphanedRun looks for the orphaned run's triggering log transaction
+// in the new chain, counting the run's confirmations from its block once it
+// is included. Runs still orphaned after ORPHANED_RUN_TIMEOUT are failed.
+func recheckOrphanedRun(run *models.JobRun, store *store.Store) error {
+	receipt, err := store.TxManager.GetTxReceipt(*run.TxHash)
+	if err != nil {
+		return err
+	}
+
+	now := store.Clock.Now()
+	if !receipt.Unconfirmed() {
+		height := hexutil.Big(*receipt.BlockNumber.ToBig())
+		logger.Infow("Orphaned run's triggering log included in the new chain", run.ForLogger("new_height", height.ToInt())...)
+		run.CreationHeight = &height
+		run.OrphanedAt = null.Time{}
+		return store.UpdateJobRunOrphaned(run, models.RunNote{
+			CreatedAt: now,
+			Text:      fmt.Sprintf("Triggering log included again in block %v", height.ToInt()),
+		})
+	}
+
+	if now.Sub(run.OrphanedAt.Time) < store.Config.OrphanedRunTimeout() {
+		return nil
+	}
+
+	logger.Warnw("Failing run whose triggering log was not included again after a chain reorganisation", run.ForLogger()...)
+	for i, tr := range run.TaskRuns {
+		if !tr.Status.Finished() {
+			run.TaskRuns[i] = tr.ApplyResult(tr.Result.WithError(ErrOrphanedLog))
+		}
+	}
+	*run = run.ApplyResult(run.Result.WithError(ErrOrphanedLog))
+	run.AddNote(now, fmt.Sprintf("Failed: triggering log was not included again within %v", store.Config.OrphanedRunTimeout()))
+	return saveAndTrigger(run, store)
+}
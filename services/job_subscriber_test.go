@@ -3,15 +3,19 @@ package services_test
 import (
 	"math/big"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/onsi/gomega"
 	"github.com/smartcontractkit/chainlink/internal/cltest"
 	"github.com/smartcontractkit/chainlink/services"
+	"github.com/smartcontractkit/chainlink/store"
 	"github.com/smartcontractkit/chainlink/store/models"
 	"github.com/smartcontractkit/chainlink/utils"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	null "gopkg.in/guregu/null.v3"
 )
 
 func TestJobSubscriber_Connect_WithJobs(t *testing.T) {
@@ -190,3 +194,110 @@ func TestJobSubscriber_OnNewHead_OnlyResumePendingConfirmations(t *testing.T) {
 		})
 	}
 }
+
+func TestJobSubscriber_OnReorg_RevalidatesOrphanedRuns(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		receipt      store.TxReceipt
+		wantOrphaned bool
+		wantCreation *big.Int
+	}{
+		{"log dropped", store.TxReceipt{}, true, big.NewInt(5)},
+		{"log moved", store.TxReceipt{Hash: cltest.NewHash(), BlockNumber: cltest.Int(6)}, false, big.NewInt(6)},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s, js, cleanup := cltest.NewJobSubscriber()
+			defer cleanup()
+			eth := cltest.MockEthOnStore(s)
+
+			job, initr := cltest.NewJobWithLogInitiator()
+			job.Tasks = []models.TaskSpec{cltest.NewTask("noop")}
+			require.NoError(t, s.SaveJob(&job))
+
+			txHash := cltest.NewHash()
+			run := job.NewRun(initr)
+			run.TxHash = &txHash
+			creation := cltest.BigHexInt(5)
+			run.CreationHeight = &creation
+			run = run.ApplyResult(models.RunResult{Status: models.RunStatusPendingConfirmations})
+			require.NoError(t, s.SaveJobRun(&run))
+
+			unaffected := job.NewRun(initr)
+			unaffected.TxHash = &txHash
+			height := cltest.BigHexInt(3)
+			unaffected.CreationHeight = &height
+			unaffected = unaffected.ApplyResult(models.RunResult{Status: models.RunStatusPendingConfirmations})
+			require.NoError(t, s.SaveJobRun(&unaffected))
+
+			eth.Register("eth_getTransactionReceipt", test.receipt)
+			js.OnReorg(&models.Reorg{CommonAncestor: cltest.IndexableBlockNumber(4), Depth: 2})
+			assert.True(t, eth.AllCalled())
+
+			run, err := s.FindJobRun(run.ID)
+			require.NoError(t, err)
+			assert.Equal(t, models.RunStatusPendingConfirmations, run.Status)
+			assert.Equal(t, test.wantOrphaned, run.Orphaned())
+			assert.Equal(t, test.wantCreation, run.CreationHeight.ToInt())
+			assert.Len(t, run.Notes, 1)
+
+			unaffected, err = s.FindJobRun(unaffected.ID)
+			require.NoError(t, err)
+			assert.Equal(t, models.RunStatusPendingConfirmations, unaffected.Status)
+			assert.Len(t, unaffected.Notes, 0)
+		})
+	}
+}
+
+func TestJobSubscriber_OnNewHead_OrphanedRuns(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		receipt      store.TxReceipt
+		orphanedFor  time.Duration
+		wantStatus   models.RunStatus
+		wantOrphaned bool
+		wantSend     bool
+	}{
+		{"included again", store.TxReceipt{Hash: cltest.NewHash(), BlockNumber: cltest.Int(6)}, time.Minute, models.RunStatusInProgress, false, true},
+		{"still orphaned", store.TxReceipt{}, time.Minute, models.RunStatusPendingConfirmations, true, false},
+		{"timed out", store.TxReceipt{}, 2 * time.Hour, models.RunStatusErrored, true, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s, js, cleanup := cltest.NewJobSubscriber()
+			defer cleanup()
+			eth := cltest.MockEthOnStore(s)
+			mockRunChannel := cltest.NewMockRunChannel()
+			s.RunChannel = mockRunChannel
+
+			job, initr := cltest.NewJobWithLogInitiator()
+			job.Tasks = []models.TaskSpec{cltest.NewTask("noop")}
+			require.NoError(t, s.SaveJob(&job))
+
+			txHash := cltest.NewHash()
+			run := job.NewRun(initr)
+			run.TxHash = &txHash
+			creation := cltest.BigHexInt(5)
+			run.CreationHeight = &creation
+			run.OrphanedAt = null.TimeFrom(time.Now().Add(-test.orphanedFor))
+			run = run.ApplyResult(models.RunResult{Status: models.RunStatusPendingConfirmations})
+			require.NoError(t, s.SaveJobRun(&run))
+
+			eth.Register("eth_getTransactionReceipt", test.receipt)
+			js.OnNewHead(cltest.NewBlockHeader(10))
+			assert.True(t, eth.AllCalled())
+
+			run, err := s.FindJobRun(run.ID)
+			require.NoError(t, err)
+			assert.Equal(t, test.wantStatus, run.Status)
+			assert.Equal(t, test.wantOrphaned, run.Orphaned())
+			assert.Equal(t, test.wantSend, len(mockRunChannel.Runs) == 1)
+		})
+	}
+}
@@ -79,6 +79,16 @@ func (mr *MockJobSubscriberMockRecorder) Jobs() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Jobs", reflect.TypeOf((*MockJobSubscriber)(nil).Jobs))
 }
 
+// RemoveJob mocks base method
+func (m *MockJobSubscriber) RemoveJob(arg0 string) {
+	m.ctrl.Call(m, "RemoveJob", arg0)
+}
+
+// RemoveJob indicates an expected call of RemoveJob
+func (mr *MockJobSubscriberMockRecorder) RemoveJob(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveJob", reflect.TypeOf((*MockJobSubscriber)(nil).RemoveJob), arg0)
+}
+
 // OnNewHead mocks base method
 func (m *MockJobSubscriber) OnNewHead(arg0 *models.BlockHeader) {
 	m.ctrl.Call(m, "OnNewHead", arg0)
@@ -88,3 +98,13 @@ func (m *MockJobSubscriber) OnNewHead(arg0 *models.BlockHeader) {
 func (mr *MockJobSubscriberMockRecorder) OnNewHead(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OnNewHead", reflect.TypeOf((*MockJobSubscriber)(nil).OnNewHead), arg0)
 }
+
+// OnReorg mocks base method
+func (m *MockJobSubscriber) OnReorg(arg0 *models.Reorg) {
+	m.ctrl.Call(m, "OnReorg", arg0)
+}
+
+// OnReorg indicates an expected call of OnReorg
+func (mr *MockJobSubscriberMockRecorder) OnReorg(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OnReorg", reflect.TypeOf((*MockJobSubscriber)(nil).OnReorg), arg0)
+}
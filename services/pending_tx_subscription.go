@@ -0,0 +1,194 @@
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/smartcontractkit/chainlink/logger"
+	strpkg "github.com/smartcontractkit/chainlink/store"
+	"github.com/smartcontractkit/chainlink/store/models"
+)
+
+// maxSeenPendingTxs bounds the number of transaction hashes remembered by a
+// PendingTxSubscription to avoid running a job twice for the same
+// transaction when the node announces it more than once.
+const maxSeenPendingTxs = 10000
+
+// PendingTxSubscription listens to transactions entering the ethereum node's
+// transaction pool on behalf of the "pendingtx" initiators of every job added
+// to it, and runs the jobs whose initiators match. The node is subscribed to
+// once, and each transaction fetched once, however many jobs are added.
+type PendingTxSubscription struct {
+	store           *strpkg.Store
+	mutex           sync.Mutex
+	jobs            map[string]models.JobSpec
+	txs             chan models.PendingTransaction
+	ethSubscription models.EthSubscription
+}
+
+// NewPendingTxSubscription returns a PendingTxSubscription, which subscribes
+// to the ethereum node's pending transactions once a job is added to it.
+func NewPendingTxSubscription(store *strpkg.Store) *PendingTxSubscription {
+	return &PendingTxSubscription{
+		store: store,
+		jobs:  map[string]models.JobSpec{},
+	}
+}
+
+// AddJob runs the job for the pending transactions matching its "pendingtx"
+// initiators, subscribing to the node's pending transactions if no other job
+// is listening to them. The returned Unsubscriber removes the job.
+func (sub *PendingTxSubscription) AddJob(job models.JobSpec) (Unsubscriber, error) {
+	sub.mutex.Lock()
+	defer sub.mutex.Unlock()
+
+	if sub.ethSubscription == nil {
+		txs := make(chan models.PendingTransaction)
+		es, err := sub.store.TxManager.SubscribeToPendingTransactions(txs)
+		if err != nil {
+			return nil, err
+		}
+		sub.txs = txs
+		sub.ethSubscription = es
+		go sub.listenToPendingTxs(txs, es)
+	}
+
+	sub.jobs[job.ID] = job
+	for _, initr := range job.InitiatorsFor(models.InitiatorPendingTransaction) {
+		logger.Infow(fmt.Sprintf("Listening for pending transactions for job %v", job.ID),
+			"to", initr.ToAddresses, "from", initr.FromAddresses)
+	}
+	return pendingTxJob{sub: sub, jobID: job.ID}, nil
+}
+
+// RemoveJob stops running the job for pending transactions, unsubscribing
+// from the node's pending transactions once no job is listening to them.
+func (sub *PendingTxSubscription) RemoveJob(jobID string) {
+	sub.mutex.Lock()
+	defer sub.mutex.Unlock()
+
+	delete(sub.jobs, jobID)
+	if len(sub.jobs) == 0 {
+		sub.unsubscribe()
+	}
+}
+
+// Unsubscribe removes every job and closes channels and cleans up resources.
+func (sub *PendingTxSubscription) Unsubscribe() {
+	sub.mutex.Lock()
+	defer sub.mutex.Unlock()
+
+	sub.jobs = map[string]models.JobSpec{}
+	sub.unsubscribe()
+}
+
+func (sub *PendingTxSubscription) unsubscribe() {
+	if sub.ethSubscription == nil {
+		return
+	}
+	timedUnsubscribe(sub.ethSubscription)
+	close(sub.txs)
+	sub.ethSubscription = nil
+	sub.txs = nil
+}
+
+// pendingTxJob is the Unsubscriber of a job added to a PendingTxSubscription.
+type pendingTxJob struct {
+	sub   *PendingTxSubscription
+	jobID string
+}
+
+// Unsubscribe removes the job from the PendingTxSubscription.
+func (ptj pendingTxJob) Unsubscribe() {
+	ptj.sub.RemoveJob(ptj.jobID)
+}
+
+func (sub *PendingTxSubscription) listenToPendingTxs(
+	txs <-chan models.PendingTransaction,
+	es models.EthSubscription,
+) {
+	seen := map[common.Hash]struct{}{}
+	errs := es.Err()
+	for {
+		select {
+		case tx, open := <-txs:
+			if !open {
+				return
+			}
+			if _, present := seen[tx.Hash]; present {
+				continue
+			}
+			if len(seen) >= maxSeenPendingTxs {
+				seen = map[common.Hash]struct{}{}
+			}
+			seen[tx.Hash] = struct{}{}
+			sub.dispatchPendingTx(tx)
+		case err, ok := <-errs:
+			if !ok {
+				errs = nil
+				continue
+			}
+			logger.Errorw(fmt.Sprintf("Error in pending transaction subscription: %s", err.Error()), "err", err)
+		}
+	}
+}
+
+func (sub *PendingTxSubscription) dispatchPendingTx(tx models.PendingTransaction) {
+	if tx.HashOnly() {
+		full, err := sub.store.TxManager.GetTransactionByHash(tx.Hash)
+		if err != nil {
+			logger.Errorw("Unable to fetch pending transaction", "txHash", tx.Hash.Hex(), "err", err)
+			return
+		} else if full.HashOnly() {
+			logger.Debugw("Pending transaction dropped before it could be fetched", "txHash", tx.Hash.Hex())
+			return
+		}
+		tx = full
+	}
+
+	for _, job := range sub.activeJobs() {
+		for _, initr := range job.InitiatorsFor(models.InitiatorPendingTransaction) {
+			if !initr.MatchesPendingTx(tx) {
+				continue
+			}
+
+			logger.Debugw(fmt.Sprintf("Pending transaction for %v initiator for job %v", initr.Type, job.ID),
+				"txHash", tx.Hash.Hex(), "from", tx.From.Hex(), "job", job.ID)
+			if err := runJobForPendingTx(sub.store, job, initr, tx); err != nil {
+				logger.Errorw(err.Error(), "txHash", tx.Hash.Hex(), "job", job.ID)
+			}
+		}
+	}
+}
+
+func (sub *PendingTxSubscription) activeJobs() []models.JobSpec {
+	sub.mutex.Lock()
+	defer sub.mutex.Unlock()
+
+	jobs := make([]models.JobSpec, 0, len(sub.jobs))
+	for _, job := range sub.jobs {
+		jobs = append(jobs, job)
+	}
+	return jobs
+}
+
+func runJobForPendingTx(
+	store *strpkg.Store,
+	job models.JobSpec,
+	initr models.Initiator,
+	tx models.PendingTransaction,
+) error {
+	b, err := json.Marshal(tx)
+	if err != nil {
+		return err
+	}
+	data, err := models.ParseJSON(b)
+	if err != nil {
+		return err
+	}
+
+	_, err = ExecuteJob(job, initr, models.RunResult{Data: data}, nil, store)
+	return err
+}
@@ -0,0 +1,128 @@
+package services_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/smartcontractkit/chainlink/internal/cltest"
+	"github.com/smartcontractkit/chainlink/services"
+	"github.com/smartcontractkit/chainlink/store/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServices_NewPendingTxSubscription(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+	eth := cltest.MockEthOnStore(store)
+
+	oracle := cltest.NewAddress()
+	job := cltest.NewJob()
+	job.Initiators = []models.Initiator{{
+		Type:            models.InitiatorPendingTransaction,
+		InitiatorParams: models.InitiatorParams{ToAddresses: []common.Address{oracle}},
+	}}
+	require.NoError(t, store.CreateJob(&job))
+
+	txs := make(chan models.PendingTransaction)
+	eth.RegisterSubscription("newPendingTransactions", txs)
+
+	sub := services.NewPendingTxSubscription(store)
+	defer sub.Unsubscribe()
+	_, err := sub.AddJob(job)
+	require.NoError(t, err)
+
+	matching := models.PendingTransaction{
+		Hash:  cltest.NewHash(),
+		From:  cltest.NewAddress(),
+		To:    &oracle,
+		Input: hexutil.Bytes{},
+	}
+	other := cltest.NewAddress()
+	txs <- models.PendingTransaction{Hash: cltest.NewHash(), From: cltest.NewAddress(), To: &other}
+	txs <- matching
+	txs <- matching // announced twice
+
+	eth.EventuallyAllCalled(t)
+	runs := cltest.WaitForRuns(t, job, store, 1)
+	assert.Equal(t, matching.Hash.Hex(), runs[0].Overrides.Data.Get("hash").String())
+}
+
+func TestServices_NewPendingTxSubscription_HashOnly(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+	eth := cltest.MockEthOnStore(store)
+
+	sender := cltest.NewAddress()
+	job := cltest.NewJob()
+	job.Initiators = []models.Initiator{{
+		Type:            models.InitiatorPendingTransaction,
+		InitiatorParams: models.InitiatorParams{FromAddresses: []common.Address{sender}},
+	}}
+	require.NoError(t, store.CreateJob(&job))
+
+	hash := cltest.NewHash()
+	to := cltest.NewAddress()
+	eth.Register("eth_getTransactionByHash", models.PendingTransaction{Hash: hash, From: sender, To: &to})
+	txs := make(chan models.PendingTransaction)
+	eth.RegisterSubscription("newPendingTransactions", txs)
+
+	sub := services.NewPendingTxSubscription(store)
+	defer sub.Unsubscribe()
+	_, err := sub.AddJob(job)
+	require.NoError(t, err)
+
+	txs <- models.PendingTransaction{Hash: hash}
+
+	eth.EventuallyAllCalled(t)
+	runs := cltest.WaitForRuns(t, job, store, 1)
+	assert.Equal(t, sender.Hex(), common.HexToAddress(runs[0].Overrides.Data.Get("from").String()).Hex())
+}
+
+func TestPendingTxSubscription_AddJob_SharesSubscription(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+	eth := cltest.MockEthOnStore(store)
+
+	sender := cltest.NewAddress()
+	newJob := func() models.JobSpec {
+		job := cltest.NewJob()
+		job.Initiators = []models.Initiator{{
+			Type:            models.InitiatorPendingTransaction,
+			InitiatorParams: models.InitiatorParams{FromAddresses: []common.Address{sender}},
+		}}
+		require.NoError(t, store.CreateJob(&job))
+		return job
+	}
+	first := newJob()
+	second := newJob()
+	removed := newJob()
+
+	hash := cltest.NewHash()
+	to := cltest.NewAddress()
+	eth.Register("eth_getTransactionByHash", models.PendingTransaction{Hash: hash, From: sender, To: &to})
+	txs := make(chan models.PendingTransaction)
+	eth.RegisterSubscription("newPendingTransactions", txs)
+
+	sub := services.NewPendingTxSubscription(store)
+	defer sub.Unsubscribe()
+	for _, job := range []models.JobSpec{first, second, removed} {
+		_, err := sub.AddJob(job)
+		require.NoError(t, err)
+	}
+	sub.RemoveJob(removed.ID)
+
+	txs <- models.PendingTransaction{Hash: hash}
+
+	eth.EventuallyAllCalled(t)
+	cltest.WaitForRuns(t, first, store, 1)
+	cltest.WaitForRuns(t, second, store, 1)
+	cltest.WaitForRuns(t, removed, store, 0)
+}
@@ -0,0 +1,295 @@
+package services
+
+import (
+	"container/heap"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/logger"
+	"github.com/smartcontractkit/chainlink/store"
+	"github.com/smartcontractkit/chainlink/store/models"
+)
+
+// runQueue bounds the number of runs executing at once. Runs wait in the
+// queue until a worker is free, which is then given to the waiting run with
+// the highest priority whose job is below its own concurrency limit.
+//
+// Waiting runs are persisted, so that they keep their place in the queue
+// when the node restarts.
+type runQueue struct {
+	store          *store.Store
+	mutex          sync.Mutex
+	executing      int
+	executingByJob map[string]int
+	waiting        waitingRuns
+	restored       map[string]models.QueuedRun
+	grants         int64
+	totalWait      time.Duration
+}
+
+type queuedRunWaiter struct {
+	entry     models.QueuedRun
+	jobLimit  uint64
+	persisted bool
+	granted   chan struct{}
+	index     int
+}
+
+func newRunQueue(store *store.Store) *runQueue {
+	return &runQueue{
+		store:          store,
+		executingByJob: make(map[string]int),
+		restored:       make(map[string]models.QueuedRun),
+	}
+}
+
+// restore loads the runs left waiting in the queue by the previous shutdown,
+// so that they keep their place in the queue once they are resumed. Entries
+// of runs that are no longer in progress are removed.
+func (rq *runQueue) restore() error {
+	qrs, err := rq.store.QueuedRuns()
+	if err != nil {
+		return err
+	}
+
+	rq.mutex.Lock()
+	defer rq.mutex.Unlock()
+	for _, qr := range qrs {
+		if run, err := rq.store.FindJobRun(qr.RunID); err != nil || run.Status != models.RunStatusInProgress {
+			if err := rq.store.DeleteQueuedRun(qr.RunID); err != nil {
+				return err
+			}
+			continue
+		}
+		rq.restored[qr.RunID] = qr
+	}
+	return nil
+}
+
+// acquire blocks until the run is given a worker, returning false if done is
+// closed first. Every successful acquire must be followed by a release.
+func (rq *runQueue) acquire(run models.JobRun, done <-chan struct{}) bool {
+	waiter := rq.enqueue(run)
+	select {
+	case <-waiter.granted:
+	case <-done:
+		if !rq.leave(waiter) {
+			rq.release(run.JobID)
+		}
+		return false
+	}
+
+	if waiter.persisted {
+		if err := rq.store.DeleteQueuedRun(run.ID); err != nil {
+			logger.Errorw("Error removing run from run queue", run.ForLogger("error", err)...)
+		}
+	}
+	return true
+}
+
+// release frees the worker held by a run of the job.
+func (rq *runQueue) release(jobID string) {
+	rq.mutex.Lock()
+	defer rq.mutex.Unlock()
+
+	rq.executing--
+	rq.executingByJob[jobID]--
+	if rq.executingByJob[jobID] <= 0 {
+		delete(rq.executingByJob, jobID)
+	}
+	rq.dispatch()
+}
+
+// tryAcquire gives the run an extra worker, for another branch of its task
+// graph, if one is free and no other run is waiting for it. It returns false
+// otherwise, without waiting. Every successful tryAcquire must be followed by
+// a release.
+func (rq *runQueue) tryAcquire(run models.JobRun) bool {
+	waiter := &queuedRunWaiter{entry: models.QueuedRun{JobID: run.JobID}, jobLimit: rq.jobLimit(run.JobID)}
+
+	rq.mutex.Lock()
+	defer rq.mutex.Unlock()
+	if rq.waiting.Len() > 0 || !rq.available(waiter) {
+		return false
+	}
+	rq.occupy(run.JobID)
+	return true
+}
+
+// stats returns the current depth of the queue and the wait times of its
+// runs.
+func (rq *runQueue) stats() models.RunQueueStats {
+	rq.mutex.Lock()
+	defer rq.mutex.Unlock()
+
+	now := rq.store.Clock.Now()
+	stats := models.RunQueueStats{
+		MaxConcurrentRuns: rq.store.Config.MaxConcurrentRuns(),
+		Executing:         rq.executing,
+		Depth:             len(rq.waiting),
+	}
+	if rq.grants > 0 {
+		stats.AverageWait = models.Duration(rq.totalWait / time.Duration(rq.grants))
+	}
+
+	jobs := map[string]*models.JobQueueStats{}
+	jobStats := func(jobID string) *models.JobQueueStats {
+		if _, ok := jobs[jobID]; !ok {
+			jobs[jobID] = &models.JobQueueStats{JobID: jobID}
+		}
+		return jobs[jobID]
+	}
+	for jobID, count := range rq.executingByJob {
+		jobStats(jobID).Executing = count
+	}
+	for _, waiter := range rq.waiting {
+		jobStats(waiter.entry.JobID).Depth++
+		if wait := models.Duration(now.Sub(waiter.entry.QueuedAt)); wait > stats.OldestWait {
+			stats.OldestWait = wait
+		}
+	}
+
+	stats.Jobs = []models.JobQueueStats{}
+	for _, js := range jobs {
+		stats.Jobs = append(stats.Jobs, *js)
+	}
+	sort.Slice(stats.Jobs, func(i, j int) bool {
+		return stats.Jobs[i].JobID < stats.Jobs[j].JobID
+	})
+	return stats
+}
+
+func (rq *runQueue) enqueue(run models.JobRun) *queuedRunWaiter {
+	waiter := &queuedRunWaiter{
+		entry:    models.NewQueuedRun(run, rq.store.Clock.Now()),
+		jobLimit: rq.jobLimit(run.JobID),
+		granted:  make(chan struct{}),
+	}
+
+	rq.mutex.Lock()
+	if entry, ok := rq.restored[run.ID]; ok {
+		delete(rq.restored, run.ID)
+		waiter.entry.QueuedAt = entry.QueuedAt
+		waiter.persisted = true
+	}
+	if rq.waiting.Len() == 0 && rq.available(waiter) {
+		rq.start(waiter)
+		rq.mutex.Unlock()
+		return waiter
+	}
+	rq.mutex.Unlock()
+
+	if !waiter.persisted {
+		if err := rq.store.SaveQueuedRun(&waiter.entry); err != nil {
+			logger.Errorw("Error persisting run in run queue", run.ForLogger("error", err)...)
+		}
+		waiter.persisted = true
+	}
+	logger.Debugw("Run waiting in run queue", run.ForLogger()...)
+
+	rq.mutex.Lock()
+	defer rq.mutex.Unlock()
+	heap.Push(&rq.waiting, waiter)
+	rq.dispatch()
+	return waiter
+}
+
+// leave removes a waiting run from the queue, returning false if the run
+// has been given a worker in the meantime.
+func (rq *runQueue) leave(waiter *queuedRunWaiter) bool {
+	rq.mutex.Lock()
+	defer rq.mutex.Unlock()
+
+	select {
+	case <-waiter.granted:
+		return false
+	default:
+		heap.Remove(&rq.waiting, waiter.index)
+		return true
+	}
+}
+
+func (rq *runQueue) jobLimit(jobID string) uint64 {
+	job, err := rq.store.FindJob(jobID)
+	if err != nil {
+		return 0
+	}
+	return job.MaxConcurrentRuns
+}
+
+// dispatch gives the free workers to the waiting runs in order of priority.
+// The runs passed over because their job is at its own limit are put back
+// once the free workers are given out. It must be called with the mutex held.
+func (rq *runQueue) dispatch() {
+	var limited []*queuedRunWaiter
+	for rq.waiting.Len() > 0 && rq.workerFree() {
+		next := heap.Pop(&rq.waiting).(*queuedRunWaiter)
+		if rq.available(next) {
+			rq.start(next)
+		} else {
+			limited = append(limited, next)
+		}
+	}
+	for _, waiter := range limited {
+		heap.Push(&rq.waiting, waiter)
+	}
+}
+
+// workerFree returns true if fewer than MAX_CONCURRENT_RUNS workers are
+// held. It must be called with the mutex held.
+func (rq *runQueue) workerFree() bool {
+	max := rq.store.Config.MaxConcurrentRuns()
+	return max == 0 || uint64(rq.executing) < max
+}
+
+// available returns true if a worker is free for the run. It must be called
+// with the mutex held.
+func (rq *runQueue) available(waiter *queuedRunWaiter) bool {
+	if !rq.workerFree() {
+		return false
+	}
+	return waiter.jobLimit == 0 || uint64(rq.executingByJob[waiter.entry.JobID]) < waiter.jobLimit
+}
+
+// occupy holds a worker for a run of the job. It must be called with the
+// mutex held.
+func (rq *runQueue) occupy(jobID string) {
+	rq.executing++
+	rq.executingByJob[jobID]++
+}
+
+// start gives a worker to the run. It must be called with the mutex held.
+func (rq *runQueue) start(waiter *queuedRunWaiter) {
+	rq.occupy(waiter.entry.JobID)
+	rq.grants++
+	rq.totalWait += rq.store.Clock.Now().Sub(waiter.entry.QueuedAt)
+	close(waiter.granted)
+}
+
+// waitingRuns is a heap of the runs waiting in the queue, with the highest
+// priority run first.
+type waitingRuns []*queuedRunWaiter
+
+func (w waitingRuns) Len() int           { return len(w) }
+func (w waitingRuns) Less(i, j int) bool { return w[i].entry.Before(w[j].entry) }
+
+func (w waitingRuns) Swap(i, j int) {
+	w[i], w[j] = w[j], w[i]
+	w[i].index = i
+	w[j].index = j
+}
+
+func (w *waitingRuns) Push(x interface{}) {
+	waiter := x.(*queuedRunWaiter)
+	waiter.index = len(*w)
+	*w = append(*w, waiter)
+}
+
+func (w *waitingRuns) Pop() interface{} {
+	old := *w
+	waiter := old[len(old)-1]
+	old[len(old)-1] = nil
+	*w = old[:len(old)-1]
+	return waiter
+}
@@ -0,0 +1,181 @@
+package services_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/onsi/gomega"
+	"github.com/smartcontractkit/chainlink/internal/cltest"
+	"github.com/smartcontractkit/chainlink/services"
+	"github.com/smartcontractkit/chainlink/store/assets"
+	"github.com/smartcontractkit/chainlink/store/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunQueue_MaxConcurrentRuns(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+	store.Config.Set("MAX_CONCURRENT_RUNS", 1)
+	queue := services.NewExportedRunQueue(store)
+
+	job, initr := cltest.NewJobWithWebInitiator()
+	require.NoError(t, store.SaveJob(&job))
+	first := job.NewRun(initr)
+	second := job.NewRun(initr)
+	done := make(chan struct{})
+	defer close(done)
+
+	require.True(t, queue.Acquire(first, done))
+
+	acquired := make(chan bool)
+	go func() { acquired <- queue.Acquire(second, done) }()
+
+	g := gomega.NewGomegaWithT(t)
+	g.Eventually(func() int { return queue.Stats().Depth }).Should(gomega.Equal(1))
+	stats := queue.Stats()
+	assert.Equal(t, 1, stats.Executing)
+	require.Len(t, stats.Jobs, 1)
+	assert.Equal(t, models.JobQueueStats{JobID: job.ID, Executing: 1, Depth: 1}, stats.Jobs[0])
+	queued, err := store.QueuedRuns()
+	require.NoError(t, err)
+	require.Len(t, queued, 1)
+	assert.Equal(t, second.ID, queued[0].RunID)
+
+	queue.Release(job.ID)
+	assert.True(t, <-acquired)
+	assert.Equal(t, 0, queue.Stats().Depth)
+	queued, err = store.QueuedRuns()
+	require.NoError(t, err)
+	assert.Len(t, queued, 0)
+}
+
+func TestRunQueue_JobMaxConcurrentRuns(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+	queue := services.NewExportedRunQueue(store)
+
+	limited, initr := cltest.NewJobWithWebInitiator()
+	limited.MaxConcurrentRuns = 1
+	require.NoError(t, store.SaveJob(&limited))
+	other, otherInitr := cltest.NewJobWithWebInitiator()
+	require.NoError(t, store.SaveJob(&other))
+	done := make(chan struct{})
+	defer close(done)
+
+	require.True(t, queue.Acquire(limited.NewRun(initr), done))
+
+	acquired := make(chan bool)
+	go func() { acquired <- queue.Acquire(limited.NewRun(initr), done) }()
+	gomega.NewGomegaWithT(t).Eventually(func() int { return queue.Stats().Depth }).Should(gomega.Equal(1))
+
+	assert.True(t, queue.Acquire(other.NewRun(otherInitr), done))
+	assert.Equal(t, 2, queue.Stats().Executing)
+
+	queue.Release(limited.ID)
+	assert.True(t, <-acquired)
+}
+
+func TestRunQueue_TryAcquire(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+	store.Config.Set("MAX_CONCURRENT_RUNS", 2)
+	queue := services.NewExportedRunQueue(store)
+
+	job, initr := cltest.NewJobWithWebInitiator()
+	require.NoError(t, store.SaveJob(&job))
+	run := job.NewRun(initr)
+	done := make(chan struct{})
+	defer close(done)
+
+	require.True(t, queue.Acquire(run, done))
+	assert.True(t, queue.TryAcquire(run))
+	assert.False(t, queue.TryAcquire(run))
+	assert.Equal(t, 2, queue.Stats().Executing)
+
+	acquired := make(chan bool)
+	go func() { acquired <- queue.Acquire(job.NewRun(initr), done) }()
+	gomega.NewGomegaWithT(t).Eventually(func() int { return queue.Stats().Depth }).Should(gomega.Equal(1))
+
+	queue.Release(job.ID)
+	assert.True(t, <-acquired)
+	queue.Release(job.ID)
+	assert.True(t, queue.TryAcquire(run))
+}
+
+func TestRunQueue_Priority(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+	store.Config.Set("MAX_CONCURRENT_RUNS", 1)
+	queue := services.NewExportedRunQueue(store)
+
+	job, initr := cltest.NewJobWithWebInitiator()
+	require.NoError(t, store.SaveJob(&job))
+	done := make(chan struct{})
+	defer close(done)
+
+	require.True(t, queue.Acquire(job.NewRun(initr), done))
+
+	g := gomega.NewGomegaWithT(t)
+	granted := make(chan string, 2)
+	for i, payment := range []int64{1, 2} {
+		run := job.NewRun(initr)
+		run.Overrides.Amount = assets.NewLink(payment)
+		go func(run models.JobRun) {
+			if queue.Acquire(run, done) {
+				granted <- run.Overrides.Amount.String()
+			}
+		}(run)
+		g.Eventually(func() int { return queue.Stats().Depth }).Should(gomega.Equal(i + 1))
+	}
+
+	queue.Release(job.ID)
+	assert.Equal(t, "2", <-granted)
+	queue.Release(job.ID)
+	assert.Equal(t, "1", <-granted)
+}
+
+func TestRunQueue_Restore(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	job, initr := cltest.NewJobWithWebInitiator()
+	require.NoError(t, store.SaveJob(&job))
+	inProgress := job.NewRun(initr)
+	inProgress.Status = models.RunStatusInProgress
+	require.NoError(t, store.SaveJobRun(&inProgress))
+	completed := job.NewRun(initr)
+	completed.Status = models.RunStatusCompleted
+	require.NoError(t, store.SaveJobRun(&completed))
+
+	queuedAt := store.Clock.Now().Add(-time.Hour)
+	for _, run := range []models.JobRun{inProgress, completed} {
+		qr := models.NewQueuedRun(run, queuedAt)
+		require.NoError(t, store.SaveQueuedRun(&qr))
+	}
+
+	queue := services.NewExportedRunQueue(store)
+	require.NoError(t, queue.Restore())
+	queued, err := store.QueuedRuns()
+	require.NoError(t, err)
+	require.Len(t, queued, 1)
+	assert.Equal(t, inProgress.ID, queued[0].RunID)
+
+	done := make(chan struct{})
+	defer close(done)
+	require.True(t, queue.Acquire(inProgress, done))
+	assert.True(t, queue.Stats().AverageWait.Duration() >= time.Hour)
+	queued, err = store.QueuedRuns()
+	require.NoError(t, err)
+	assert.Len(t, queued, 0)
+}
@@ -3,14 +3,19 @@ package services
 import (
 	"fmt"
 	"math/big"
+	"strings"
+	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/smartcontractkit/chainlink/adapters"
 	"github.com/smartcontractkit/chainlink/logger"
 	"github.com/smartcontractkit/chainlink/store"
 	"github.com/smartcontractkit/chainlink/store/assets"
 	"github.com/smartcontractkit/chainlink/store/models"
+	"github.com/smartcontractkit/chainlink/store/orm"
 	"github.com/smartcontractkit/chainlink/utils"
+	null "gopkg.in/guregu/null.v3"
 )
 
 // ExecuteJob saves and immediately begins executing a run for a specified job
@@ -36,6 +41,57 @@ func ExecuteJob(
 	return run, saveAndTrigger(run, store)
 }
 
+// ExecuteJobWithLog saves and immediately begins executing a run for the job
+// and initiator of the passed log request, recording the originating log on
+// the run so that it can later be matched when backfilling or when the log
+// is delivered again.
+func ExecuteJobWithLog(
+	le models.LogRequest,
+	input models.RunResult,
+	store *store.Store) (*models.JobRun, error) {
+
+	job := le.GetJobSpec()
+	initiator := le.GetInitiator()
+	log := le.GetLog()
+	creationHeight := le.ToIndexableBlockNumber().Number
+
+	logger.Debugw(fmt.Sprintf("New run triggered by %s", initiator.Type),
+		"job", job.ID,
+		"input_status", input.Status,
+		"creation_height", creationHeight.ToInt(),
+		"tx_hash", log.TxHash.Hex(),
+		"log_index", log.Index,
+	)
+
+	run, err := NewRun(job, initiator, input, &creationHeight, store)
+	if err != nil {
+		return nil, err
+	}
+	run.TxHash = &log.TxHash
+	run.LogKey = models.LogKey(le)
+	run.ExpiresAt = le.RequestExpiration()
+
+	return run, saveAndTrigger(run, store)
+}
+
+// FlagRemovedLogRun marks the run initiated by the log request as having
+// lost its initiating log to a chain reorganisation. The run is left to
+// finish, since the request may well be mined again.
+func FlagRemovedLogRun(le models.LogRequest, store *store.Store) error {
+	run, err := store.FindJobRunByLogKey(models.LogKey(le))
+	if err == orm.ErrorNotFound {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	logger.Warnw("Initiating log of run was removed by a reorg", run.ForLogger("block_hash", le.GetLog().BlockHash.Hex())...)
+	run.LogRemoved = true
+	run.AddNote(store.Clock.Now(), fmt.Sprintf(
+		"Initiating log in block %s was removed by a chain reorganisation", le.GetLog().BlockHash.Hex()))
+	return store.SaveJobRun(&run)
+}
+
 // NewRun returns a run from an input job, in an initial state ready for
 // processing by the job runner system
 func NewRun(
@@ -45,6 +101,10 @@ func NewRun(
 	currentHeight *hexutil.Big,
 	store *store.Store) (*models.JobRun, error) {
 
+	if !job.Active() {
+		return nil, fmt.Errorf("Job runner: Job %v is %s", job.ID, job.Status)
+	}
+
 	now := store.Clock.Now()
 	if !job.Started(now) {
 		return nil, RecurringScheduleJobError{
@@ -184,42 +244,58 @@ func ResumeConnectingTask(
 }
 
 // ResumePendingTask takes the body provided from an external adapter,
-// saves it for the next task to process, then tells the job runner to execute
-// it
+// saves it as the result of the task run awaiting it, and for a linear run
+// for the tasks after it, then tells the job runner to execute the remaining
+// tasks. The run stays pending while another
+// branch of its task graph still awaits its own external adapter.
 func ResumePendingTask(
 	run *models.JobRun,
 	store *store.Store,
+	taskRunID string,
 	input models.RunResult,
 ) (*models.JobRun, error) {
 
 	logger.Debugw("External adapter resuming job", []interface{}{
 		"run", run.ID,
 		"job", run.JobID,
+		"task_run", taskRunID,
 		"status", run.Status,
 		"input_data", input.Data,
 		"input_result", input.Status,
 	}...)
 
-	if !run.Status.PendingBridge() {
-		return run, fmt.Errorf("Attempting to resume non pending run %s", run.ID)
+	index, err := run.BridgeTaskRunIndex(taskRunID)
+	if err != nil {
+		return run, err
 	}
+	currentTaskRun := run.TaskRuns[index]
 
-	currentTaskRunIndex, ok := run.NextTaskRunIndex()
-	if !ok {
-		return run, fmt.Errorf("Attempting to resume pending run with no remaining tasks %s", run.ID)
+	// Linear runs keep passing the response on to later tasks through the
+	// run's overrides, as they always have. Branches of a task graph only
+	// see it through their dependencies.
+	if !run.IsGraph() {
+		run.Overrides, err = run.Overrides.Merge(input)
 	}
-	currentTaskRun := run.TaskRuns[currentTaskRunIndex]
-
-	var err error
-	if run.Overrides, err = run.Overrides.Merge(input); err != nil {
-		run.TaskRuns[currentTaskRunIndex] = currentTaskRun.ApplyResult(input.WithError(err))
+	if err == nil {
+		input.Data, err = currentTaskRun.Result.Data.Merge(input.Data)
+	}
+	if err != nil {
+		run.TaskRuns[index] = currentTaskRun.ApplyResult(input.WithError(err))
 		*run = run.ApplyResult(input.WithError(err))
 		return run, store.SaveJobRun(run)
 	}
+	input.JobRunID = run.ID
+	input.TaskRunID = currentTaskRun.ID
 
 	currentTaskRun = currentTaskRun.ApplyResult(input)
-	run.TaskRuns[currentTaskRunIndex] = currentTaskRun
-	if currentTaskRun.Status.Finished() && run.TasksRemain() {
+	run.TaskRuns[index] = currentTaskRun
+	if !currentTaskRun.Status.Completed() {
+		*run = run.ApplyResult(input)
+	} else if run.IsGraph() && len(run.ReadyTaskRunIndexes()) > 0 {
+		run.Status = models.RunStatusInProgress
+	} else if next := run.NextTaskRun(); next != nil && next.Status.Pending() {
+		*run = run.ApplyResult(next.Result)
+	} else if run.TasksRemain() {
 		run.Status = models.RunStatusInProgress
 	} else {
 		*run = run.ApplyResult(input)
@@ -263,6 +339,62 @@ func QueueSleepingTask(
 	return run, fmt.Errorf("Attempting to resume non sleeping task for run %s (%s)", run.ID, currentTaskRun.Task.Type)
 }
 
+// QueueRetryingTask creates a go routine which will wake up the job runner
+// once the earliest retry of the run's errored tasks is due. The retry is
+// scheduled from the RetryAt persisted on the task run, so that retries
+// survive a restart of the node.
+func QueueRetryingTask(
+	run *models.JobRun,
+	store *store.Store,
+) (*models.JobRun, error) {
+	var retryAt *time.Time
+	for _, tr := range run.TaskRuns {
+		if tr.Status.PendingRetry() && (retryAt == nil || tr.RetryAt.Time.Before(*retryAt)) {
+			retryAt = &tr.RetryAt.Time
+		}
+	}
+	if retryAt == nil {
+		return run, fmt.Errorf("Attempting to retry run with no retrying tasks %s", run.ID)
+	}
+
+	go func(runID string, delay time.Duration) {
+		<-store.Clock.After(delay)
+		if err := retryTasks(runID, store); err != nil {
+			logger.Errorw("Error retrying task:", "run", runID, "error", err)
+		}
+	}(run.ID, retryAt.Sub(store.Clock.Now()))
+
+	return run, nil
+}
+
+// retryTasks readies the run's errored tasks whose retry is due to be
+// executed again, reloading the run since others may have updated it while
+// waiting.
+func retryTasks(runID string, store *store.Store) error {
+	run, err := store.FindJobRun(runID)
+	if err != nil {
+		return err
+	} else if run.Status.Finished() {
+		return nil
+	}
+
+	now := store.Clock.Now()
+	retrying := false
+	for i, tr := range run.TaskRuns {
+		if tr.Status.PendingRetry() && !tr.RetryAt.Time.After(now) {
+			logger.Debugw("Retrying task", run.ForLogger("task", tr.ID, "attempts", len(tr.Attempts))...)
+			run.TaskRuns[i] = tr.MarkRetrying()
+			retrying = true
+		}
+	}
+	if !retrying {
+		return nil
+	}
+
+	run.Status = models.RunStatusInProgress
+	return saveAndTrigger(&run, store)
+}
+
 func performTaskSleep(
 	run *models.JobRun,
 	task *models.TaskRun,
@@ -279,17 +411,20 @@ func performTaskSleep(
 		return saveAndTrigger(run, store)
 	}
 
-	// XXX: This is to eliminate data race that occurs because slices share their
-	// underlying array even in copies
-	runCopy := *run
-	runCopy.TaskRuns = make([]models.TaskRun, len(run.TaskRuns))
-	copy(runCopy.TaskRuns, run.TaskRuns)
-
-	go func(run models.JobRun, task models.TaskRun) {
-		logger.Debugw("Task sleeping...", run.ForLogger()...)
-
+	logger.Debugw("Task sleeping...", run.ForLogger()...)
+	go func(runID string, task models.TaskRun) {
 		<-store.Clock.After(duration)
 
+		// Reload the run, which may have been cancelled while sleeping
+		run, err := store.FindJobRun(runID)
+		if err != nil {
+			logger.Errorw("Error resuming sleeping job:", "run", runID, "error", err)
+			return
+		} else if !run.Status.PendingSleep() {
+			logger.Debugw("Run no longer sleeping, not waking", run.ForLogger()...)
+			return
+		}
+
 		task.Status = models.RunStatusCompleted
 		run.TaskRuns[currentTaskRunIndex] = task
 		run.Status = models.RunStatusInProgress
@@ -299,7 +434,7 @@ func performTaskSleep(
 		if err := saveAndTrigger(&run, store); err != nil {
 			logger.Errorw("Error resuming sleeping job:", "error", err)
 		}
-	}(runCopy, *task)
+	}(run.ID, *task)
 
 	return nil
 }
@@ -308,6 +443,9 @@ func meetsMinimumConfirmations(
 	run *models.JobRun,
 	taskRun *models.TaskRun,
 	currentHeight *hexutil.Big) bool {
+	if run.Orphaned() {
+		return false
+	}
 	if run.CreationHeight == nil || currentHeight == nil {
 		return true
 	}
@@ -329,9 +467,231 @@ func saveAndTrigger(run *models.JobRun, store *store.Store) error {
 	}
 
 	logger.Debugw(fmt.Sprintf("Pausing run originally initiated by %s", run.Initiator.Type), run.ForLogger()...)
+
+	if run.Status.Finished() && !run.ChildrenTriggered(run.Status) {
+		return triggerChildRuns(run, store)
+	}
 	return nil
 }
 
+// triggerChildRuns starts a run for every active job chained to the finished
+// run's job, passing the parent's result data as the child's input. The
+// parent's status is recorded so that the children are only started once for
+// it.
+func triggerChildRuns(parent *models.JobRun, store *store.Store) error {
+	initrs, err := store.FindChildInitiators(parent.JobID)
+	if err != nil {
+		return err
+	}
+
+	for _, initr := range initrs {
+		if !initr.TriggeredByRunStatus(parent.Status) {
+			continue
+		}
+
+		job, err := store.FindJob(initr.JobID)
+		if err != nil {
+			logger.Errorw("Error finding chained job", parent.ForLogger("child_job", initr.JobID, "error", err)...)
+			continue
+		}
+
+		child, err := NewRun(job, initr, models.RunResult{Data: parent.Result.Data}, nil, store)
+		if err != nil {
+			logger.Errorw("Error creating chained run", parent.ForLogger("child_job", job.ID, "error", err)...)
+			continue
+		}
+		child.ParentRunID = parent.ID
+
+		logger.Debugw("New run triggered by run completion", parent.ForLogger("child_job", job.ID, "child_run", child.ID)...)
+		if err := saveAndTrigger(child, store); err != nil {
+			logger.Errorw("Error starting chained run", child.ForLogger("error", err)...)
+			continue
+		}
+		parent.ChildRunIDs = append(parent.ChildRunIDs, child.ID)
+	}
+
+	if len(initrs) == 0 {
+		return nil
+	}
+	parent.ChildrenTriggeredFor = append(parent.ChildrenTriggeredFor, parent.Status)
+	return store.SaveJobRun(parent)
+}
+
+// ResumeErroredRun resets the errored tasks of the run, giving them all of
+// their retries again, and executes it again from those tasks, with the run's
+// original input and overrides. Any params passed are merged into the params
+// of the reset tasks. A note records who resumed the run.
+func ResumeErroredRun(
+	run *models.JobRun,
+	store *store.Store,
+	params models.JSON,
+	resumedBy string,
+) (*models.JobRun, error) {
+	if !run.Status.Errored() {
+		return run, fmt.Errorf("Cannot resume run %s with status %s", run.ID, run.Status)
+	}
+
+	var resumed []string
+	for i, tr := range run.TaskRuns {
+		if !tr.Status.Errored() {
+			continue
+		}
+		tr = tr.MarkResumed()
+		if len(params.Map()) > 0 {
+			var err error
+			if tr.Task.Params, err = tr.Task.Params.Merge(params); err != nil {
+				return run, err
+			}
+		}
+		run.TaskRuns[i] = tr
+		resumed = append(resumed, fmt.Sprintf("%s (%s)", tr.Task.Type, tr.ID))
+	}
+	if len(resumed) == 0 {
+		return run, fmt.Errorf("Run %s has no errored tasks to resume from", run.ID)
+	}
+
+	note := fmt.Sprintf("Resumed by %s from errored task %s", resumedBy, strings.Join(resumed, ", "))
+	if len(params.Map()) > 0 {
+		note = fmt.Sprintf("%s with params %s", note, params.String())
+	}
+	run.AddNote(store.Clock.Now(), note)
+
+	logger.Infow("Resuming errored run", run.ForLogger("resumed_by", resumedBy)...)
+	run.Result = models.RunResult{JobRunID: run.ID, Data: run.Result.Data}
+	run.CompletedAt = null.Time{}
+	run.Status = models.RunStatusInProgress
+	return run, saveAndTrigger(run, store)
+}
+
+// CancelRun stops the run from executing any further, marking it and its
+// unfinished tasks as cancelled. Runs that have already broadcast an
+// ethereum transaction are only cancelled when forced, leaving a note on the
+// run since the transaction may still be confirmed.
+func CancelRun(run *models.JobRun, store *store.Store, force bool) (*models.JobRun, error) {
+	if run.Status.Finished() {
+		return run, fmt.Errorf("Cannot cancel run %s with status %s", run.ID, run.Status)
+	}
+
+	if txHash, broadcast := broadcastTxHash(run); broadcast {
+		if !force {
+			return run, EthTxBroadcastError{RunID: run.ID, TxHash: txHash}
+		}
+		run.AddNote(store.Clock.Now(), fmt.Sprintf(
+			"Cancelled by force after broadcasting transaction %s, which may still be confirmed", txHash))
+	}
+
+	logger.Infow("Cancelling run", run.ForLogger()...)
+	*run = run.Cancel()
+	if err := saveAndTrigger(run, store); err != nil {
+		return run, err
+	}
+
+	// Wake the run's worker so that it sees the cancellation and exits
+	return run, store.RunChannel.Send(run.ID)
+}
+
+// broadcastTxHash returns the hash of the transaction sent by an EthTx task
+// of the run, if any.
+func broadcastTxHash(run *models.JobRun) (string, bool) {
+	for _, tr := range run.TaskRuns {
+		if tr.Task.Type != adapters.TaskTypeEthTx {
+			continue
+		}
+		if tr.Status.PendingConfirmations() || tr.Status.Completed() {
+			return tr.Result.Get("value").String(), true
+		}
+	}
+	return "", false
+}
+
+// SpeedUpTx replaces the transaction with the given hash with a new attempt
+// at the given gas price, noting it on the runs waiting for the transaction
+// to be confirmed.
+func SpeedUpTx(hash common.Hash, gasPrice *big.Int, store *store.Store) (*models.Tx, error) {
+	tx, err := store.TxManager.SpeedUpTx(hash, gasPrice)
+	if err != nil {
+		return nil, err
+	}
+
+	runs, err := runsWaitingOnTx(tx, store)
+	if err != nil {
+		return tx, err
+	}
+	for i := range runs {
+		run := &runs[i]
+		run.AddNote(store.Clock.Now(), fmt.Sprintf(
+			"Transaction sped up with attempt %s at gas price %v", tx.Hash.Hex(), tx.GasPrice))
+		if err := store.SaveJobRun(run); err != nil {
+			return tx, err
+		}
+	}
+	return tx, nil
+}
+
+// CancelTx replaces the transaction with the given hash with a zero value
+// transaction to its sender, at the given gas price or a bumped one if nil,
+// noting it on the runs waiting for the transaction to be confirmed. The runs
+// are only cancelled once the replacement is confirmed, and complete as usual
+// if an earlier attempt is confirmed instead.
+func CancelTx(hash common.Hash, gasPrice *big.Int, store *store.Store) (*models.Tx, error) {
+	tx, err := store.TxManager.CancelTx(hash, gasPrice)
+	if err != nil {
+		return nil, err
+	}
+
+	runs, err := runsWaitingOnTx(tx, store)
+	if err != nil {
+		return tx, err
+	}
+	for i := range runs {
+		run := &runs[i]
+		run.AddNote(store.Clock.Now(), fmt.Sprintf(
+			"Transaction replaced with %s, a zero value transaction to %s, which cancels the run once confirmed. "+
+				"An earlier attempt of the transaction may still be confirmed instead", tx.Hash.Hex(), tx.From.Hex()))
+		if err := store.SaveJobRun(run); err != nil {
+			return tx, err
+		}
+	}
+	return tx, nil
+}
+
+// runsWaitingOnTx returns the runs with an EthTx task waiting for an attempt
+// of the transaction to be confirmed.
+func runsWaitingOnTx(tx *models.Tx, store *store.Store) ([]models.JobRun, error) {
+	attempts, err := store.TxAttemptsFor(tx.ID)
+	if err != nil {
+		return nil, err
+	}
+	hashes := map[common.Hash]bool{}
+	for _, txat := range attempts {
+		hashes[txat.Hash] = true
+	}
+
+	runs, err := store.JobRunsWithStatus(models.RunStatusPendingConfirmations)
+	if err != nil {
+		return nil, err
+	}
+	waiting := []models.JobRun{}
+	for _, run := range runs {
+		if txHash, broadcast := broadcastTxHash(&run); broadcast && hashes[common.HexToHash(txHash)] {
+			waiting = append(waiting, run)
+		}
+	}
+	return waiting, nil
+}
+
+// EthTxBroadcastError is returned when cancelling a run that has already
+// broadcast an ethereum transaction without forcing it.
+type EthTxBroadcastError struct {
+	RunID  string
+	TxHash string
+}
+
+// Error returns the error message for the run.
+func (err EthTxBroadcastError) Error() string {
+	return fmt.Sprintf("Run %s has already broadcast transaction %s, cancel with force to cancel anyway", err.RunID, err.TxHash)
+}
+
 // RecurringScheduleJobError contains the field for the error message.
 type RecurringScheduleJobError struct {
 	msg string
@@ -14,6 +14,7 @@ import (
 	"github.com/smartcontractkit/chainlink/store/models"
 	"github.com/smartcontractkit/chainlink/utils"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/tidwall/gjson"
 	null "gopkg.in/guregu/null.v3"
 )
@@ -166,18 +167,66 @@ func TestNewRun_startAtAndEndAt(t *testing.T) {
 	}
 }
 
+func TestSaveAndTrigger_ChildRuns(t *testing.T) {
+	t.Parallel()
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	parent, initr := cltest.NewJobWithWebInitiator()
+	require.NoError(t, store.SaveJob(&parent))
+
+	newChild := func(statuses ...models.RunStatus) models.JobSpec {
+		child := cltest.NewJob()
+		child.Initiators = []models.Initiator{{
+			Type: models.InitiatorRunCompletion,
+			InitiatorParams: models.InitiatorParams{
+				ParentJobID:    parent.ID,
+				ParentStatuses: statuses,
+			},
+		}}
+		require.NoError(t, store.SaveJob(&child))
+		return child
+	}
+	onErrored := newChild(models.RunStatusErrored)
+	onCompleted := newChild()
+	paused := newChild()
+	require.NoError(t, store.SetJobStatus(&paused, models.JobSpecStatusPaused, store.Clock.Now()))
+
+	assertRuns := func(job models.JobSpec, want int) {
+		runs, err := store.JobRunsFor(job.ID)
+		require.NoError(t, err)
+		assert.Len(t, runs, want)
+	}
+
+	run := parent.NewRun(initr)
+	run.Status = models.RunStatusErrored
+	require.NoError(t, services.ExportedSaveAndTrigger(&run, store))
+	assertRuns(onErrored, 1)
+	assertRuns(onCompleted, 0)
+
+	// Resumed to completion after erroring
+	run = run.MarkCompleted()
+	require.NoError(t, services.ExportedSaveAndTrigger(&run, store))
+	require.NoError(t, services.ExportedSaveAndTrigger(&run, store))
+	assertRuns(onErrored, 1)
+	assertRuns(onCompleted, 1)
+	assertRuns(paused, 0)
+	assert.Equal(t, []models.RunStatus{models.RunStatusErrored, models.RunStatusCompleted}, run.ChildrenTriggeredFor)
+	assert.Len(t, run.ChildRunIDs, 2)
+}
+
 func TestResumePendingTask(t *testing.T) {
 	store, cleanup := cltest.NewStore()
 	defer cleanup()
 
 	// reject a run with an invalid state
 	run := &models.JobRun{}
-	run, err := services.ResumePendingTask(run, store, models.RunResult{})
+	run, err := services.ResumePendingTask(run, store, "", models.RunResult{})
 	assert.Error(t, err)
 
 	// reject a run with no tasks
 	run = &models.JobRun{Status: models.RunStatusPendingBridge}
-	run, err = services.ResumePendingTask(run, store, models.RunResult{})
+	run, err = services.ResumePendingTask(run, store, "", models.RunResult{})
 	assert.Error(t, err)
 
 	// input with error errors run
@@ -185,7 +234,7 @@ func TestResumePendingTask(t *testing.T) {
 		Status:   models.RunStatusPendingBridge,
 		TaskRuns: []models.TaskRun{models.TaskRun{}},
 	}
-	run, err = services.ResumePendingTask(run, store, models.RunResult{Status: models.RunStatusErrored})
+	run, err = services.ResumePendingTask(run, store, "", models.RunResult{Status: models.RunStatusErrored})
 	assert.Error(t, err)
 
 	// completed input with remaining tasks should put task into pending
@@ -194,19 +243,21 @@ func TestResumePendingTask(t *testing.T) {
 		TaskRuns: []models.TaskRun{models.TaskRun{}, models.TaskRun{}},
 	}
 	input := models.JSON{Result: gjson.Parse(`{"address":"0xdfcfc2b9200dbb10952c2b7cce60fc7260e03c6f"}`)}
-	run, err = services.ResumePendingTask(run, store, models.RunResult{Data: input, Status: models.RunStatusCompleted})
+	run, err = services.ResumePendingTask(run, store, "", models.RunResult{Data: input, Status: models.RunStatusCompleted})
 	assert.Error(t, err)
 	assert.Equal(t, string(models.RunStatusInProgress), string(run.Status))
 	assert.Len(t, run.TaskRuns, 2)
 	assert.Equal(t, run.ID, run.TaskRuns[0].Result.JobRunID)
 	assert.Equal(t, string(models.RunStatusCompleted), string(run.TaskRuns[0].Result.Status))
+	assert.Equal(t, "0xdfcfc2b9200dbb10952c2b7cce60fc7260e03c6f", run.Overrides.Data.Get("address").String(),
+		"a linear run passes the response on to later tasks")
 
 	// completed input with no remaining tasks should get marked as complete
 	run = &models.JobRun{
 		Status:   models.RunStatusPendingBridge,
 		TaskRuns: []models.TaskRun{models.TaskRun{}},
 	}
-	run, err = services.ResumePendingTask(run, store, models.RunResult{Data: input, Status: models.RunStatusCompleted})
+	run, err = services.ResumePendingTask(run, store, "", models.RunResult{Data: input, Status: models.RunStatusCompleted})
 	assert.Error(t, err)
 	assert.Equal(t, string(models.RunStatusCompleted), string(run.Status))
 	assert.Len(t, run.TaskRuns, 1)
@@ -214,6 +265,47 @@ func TestResumePendingTask(t *testing.T) {
 	assert.Equal(t, string(models.RunStatusCompleted), string(run.TaskRuns[0].Result.Status))
 }
 
+func TestResumePendingTask_ParallelBridges(t *testing.T) {
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	job, initiator := cltest.NewJobWithWebInitiator()
+	first := cltest.NewTask("bridge")
+	first.ID = "first"
+	second := cltest.NewTask("bridge")
+	second.ID = "second"
+	join := cltest.NewTask("noop")
+	join.DependsOn = []string{"first", "second"}
+	job.Tasks = []models.TaskSpec{first, second, join}
+	require.NoError(t, store.CreateJob(&job))
+
+	run := job.NewRun(initiator)
+	run = cltest.MarkJobRunPendingBridge(run, 0)
+	run = cltest.MarkJobRunPendingBridge(run, 1)
+	run.Overrides = models.RunResult{Data: cltest.JSONFromString(`{"result":"100"}`)}
+	require.NoError(t, store.SaveJobRun(&run))
+
+	_, err := services.ResumePendingTask(&run, store, "", cltest.RunResultWithValue("2"))
+	assert.Error(t, err, "the task run to resume must be identified")
+
+	resumed, err := services.ResumePendingTask(&run, store, run.TaskRuns[1].ID, cltest.RunResultWithValue("2"))
+	require.NoError(t, err)
+	assert.Equal(t, models.RunStatusPendingBridge, resumed.Status)
+	assert.Equal(t, models.RunStatusPendingBridge, resumed.TaskRuns[0].Status)
+	assert.Equal(t, models.RunStatusCompleted, resumed.TaskRuns[1].Status)
+	assert.Equal(t, "2", resumed.TaskRuns[1].Result.Data.Get("value").String())
+	assert.Equal(t, `{"result":"100"}`, resumed.Overrides.Data.String())
+
+	_, err = services.ResumePendingTask(resumed, store, resumed.TaskRuns[1].ID, cltest.RunResultWithValue("3"))
+	assert.Error(t, err, "the task run is no longer pending")
+
+	resumed, err = services.ResumePendingTask(resumed, store, resumed.TaskRuns[0].ID, cltest.RunResultWithValue("1"))
+	require.NoError(t, err)
+	assert.Equal(t, models.RunStatusInProgress, resumed.Status)
+	assert.Equal(t, "1", resumed.TaskRuns[0].Result.Data.Get("value").String())
+	assert.Equal(t, "2", resumed.TaskRuns[1].Result.Data.Get("value").String())
+}
+
 func TestResumeConfirmingTask(t *testing.T) {
 	store, cleanup := cltest.NewStore()
 	defer cleanup()
@@ -2,6 +2,7 @@ package services
 
 import (
 	"errors"
+	"math/rand"
 	"sync"
 	"time"
 
@@ -9,6 +10,7 @@ import (
 	"github.com/smartcontractkit/chainlink/logger"
 	"github.com/smartcontractkit/chainlink/store"
 	"github.com/smartcontractkit/chainlink/store/models"
+	null "gopkg.in/guregu/null.v3"
 )
 
 // Scheduler contains fields for Recurring and OneTime for occurrences,
@@ -72,6 +74,9 @@ func (s *Scheduler) Stop() {
 }
 
 func (s *Scheduler) addJob(job models.JobSpec) {
+	if !job.Active() {
+		return
+	}
 	s.Recurring.AddJob(job)
 	s.OneTime.AddJob(job)
 }
@@ -87,13 +92,80 @@ func (s *Scheduler) AddJob(job models.JobSpec) {
 	s.addJob(job)
 }
 
+// RemoveJob stops scheduling runs for the job's "cron" and "runat"
+// initiators.
+func (s *Scheduler) RemoveJob(ID string) {
+	s.Recurring.RemoveJob(ID)
+	s.OneTime.RemoveJob(ID)
+}
+
+// jobRegistry keeps track of the jobs added to a scheduler, handing out a
+// channel per job that is closed once the job is removed.
+type jobRegistry struct {
+	mutex sync.Mutex
+	jobs  map[string]chan struct{}
+}
+
+// add registers the job, removing any previous registration of it.
+func (jr *jobRegistry) add(jobID string) <-chan struct{} {
+	jr.mutex.Lock()
+	defer jr.mutex.Unlock()
+	jr.removeLocked(jobID)
+	return jr.getLocked(jobID)
+}
+
+// get returns the channel of the job's current registration.
+func (jr *jobRegistry) get(jobID string) <-chan struct{} {
+	jr.mutex.Lock()
+	defer jr.mutex.Unlock()
+	return jr.getLocked(jobID)
+}
+
+func (jr *jobRegistry) getLocked(jobID string) <-chan struct{} {
+	if jr.jobs == nil {
+		jr.jobs = map[string]chan struct{}{}
+	}
+	removed, ok := jr.jobs[jobID]
+	if !ok {
+		removed = make(chan struct{})
+		jr.jobs[jobID] = removed
+	}
+	return removed
+}
+
+func (jr *jobRegistry) remove(jobID string) {
+	jr.mutex.Lock()
+	defer jr.mutex.Unlock()
+	jr.removeLocked(jobID)
+}
+
+func (jr *jobRegistry) removeLocked(jobID string) {
+	if removed, ok := jr.jobs[jobID]; ok {
+		close(removed)
+		delete(jr.jobs, jobID)
+	}
+}
+
+func isRemoved(removed <-chan struct{}) bool {
+	select {
+	case <-removed:
+		return true
+	default:
+		return false
+	}
+}
+
 // Recurring is used for runs that need to execute on a schedule,
 // and is configured with cron.
 // Instances of Recurring must be initialized using NewRecurring().
 type Recurring struct {
-	Cron  Cron
-	Clock Nower
-	store *store.Store
+	Cron     Cron
+	Clock    Nower
+	store    *store.Store
+	done     chan struct{}
+	registry jobRegistry
+	mutex    sync.Mutex
+	entries  map[string][]CronEntryID
 }
 
 // NewRecurring create a new instance of Recurring, ready to use.
@@ -101,12 +173,17 @@ func NewRecurring(store *store.Store) *Recurring {
 	return &Recurring{
 		store: store,
 		Clock: store.Clock,
+		done:  make(chan struct{}),
 	}
 }
 
 // Start for Recurring types executes tasks with a "cron" initiator
 // based on the configured schedule for the run.
 func (r *Recurring) Start() error {
+	r.done = make(chan struct{})
+	r.mutex.Lock()
+	r.entries = nil
+	r.mutex.Unlock()
 	r.Cron = newChainlinkCron()
 	r.Cron.Start()
 	return nil
@@ -114,30 +191,248 @@ func (r *Recurring) Start() error {
 
 // Stop stops the cron scheduler and waits for running jobs to finish.
 func (r *Recurring) Stop() {
+	close(r.done)
 	r.Cron.Stop()
 }
 
 // AddJob looks for "cron" initiators, adds them to cron's schedule
 // for execution when specified.
 func (r *Recurring) AddJob(job models.JobSpec) {
+	r.removeEntries(job.ID)
+	removed := r.registry.add(job.ID)
 	for _, i := range job.InitiatorsFor(models.InitiatorCron) {
 		initr := i
-		if !job.Ended(r.Clock.Now()) {
-			r.Cron.AddFunc(string(initr.Schedule), func() {
-				_, err := ExecuteJob(job, initr, models.RunResult{}, nil, r.store)
-				if err != nil && !expectedRecurringScheduleJobError(err) {
-					logger.Errorw(err.Error())
-				}
-			})
+		if job.Ended(r.Clock.Now()) {
+			continue
+		}
+
+		schedule, err := cronSchedule(initr)
+		if err != nil {
+			logger.Errorw("Unable to schedule cron initiator", "job", job.ID, "error", err)
+			continue
+		}
+
+		queue := &sync.Mutex{}
+		r.catchUpMissedRuns(job, initr, schedule, queue, removed)
+		id := r.Cron.Schedule(schedule, cron.FuncJob(func() {
+			r.runScheduledJob(job, initr, queue, removed)
+		}))
+		r.addEntry(job.ID, id)
+	}
+}
+
+// RemoveJob stops running the job's "cron" initiators and removes their
+// entries from the cron schedule.
+func (r *Recurring) RemoveJob(ID string) {
+	r.registry.remove(ID)
+	r.removeEntries(ID)
+}
+
+func (r *Recurring) addEntry(jobID string, id CronEntryID) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if r.entries == nil {
+		r.entries = map[string][]CronEntryID{}
+	}
+	r.entries[jobID] = append(r.entries[jobID], id)
+}
+
+func (r *Recurring) removeEntries(jobID string) {
+	r.mutex.Lock()
+	ids := r.entries[jobID]
+	delete(r.entries, jobID)
+	r.mutex.Unlock()
+
+	for _, id := range ids {
+		r.Cron.Remove(id)
+	}
+}
+
+// defaultMisfireLimit caps the number of missed runs started under
+// MisfirePolicyFireAll when the initiator does not set a MisfireLimit.
+const defaultMisfireLimit = 100
+
+// catchUpMissedRuns applies the initiator's misfire policy to the ticks of
+// its schedule that passed since it last fired, or since the job was created
+// if it has never fired.
+func (r *Recurring) catchUpMissedRuns(
+	job models.JobSpec,
+	initr models.Initiator,
+	schedule cron.Schedule,
+	queue *sync.Mutex,
+	removed <-chan struct{},
+) {
+	since := job.CreatedAt.Time
+	if job.UpdatedAt.After(since) {
+		since = job.UpdatedAt.Time
+	}
+	if persisted, err := r.store.FindInitiator(initr.ID); err == nil && persisted.LastFiredAt.Valid && persisted.LastFiredAt.Time.After(since) {
+		since = persisted.LastFiredAt.Time
+	}
+
+	limit := int(initr.MisfireLimit)
+	if limit == 0 {
+		limit = defaultMisfireLimit
+	}
+
+	missed := 0
+	for next := schedule.Next(since); !next.After(r.Clock.Now()) && missed < limit; next = schedule.Next(next) {
+		missed++
+	}
+	if missed == 0 {
+		return
+	}
+
+	policy := initr.EffectiveMisfirePolicy()
+	fires := 0
+	switch policy {
+	case models.MisfirePolicyFireOnce:
+		fires = 1
+	case models.MisfirePolicyFireAll:
+		fires = missed
+	}
+
+	logger.Infow("Applying misfire policy to missed scheduled runs",
+		"job", job.ID,
+		"initiator", initr.ID,
+		"policy", policy,
+		"last_fired_at", since,
+		"missed", missed,
+		"limit", limit,
+		"firing", fires,
+	)
+
+	if fires > 0 {
+		go func() {
+			for i := 0; i < fires; i++ {
+				r.runScheduledJob(job, initr, queue, removed)
+			}
+		}()
+	}
+}
+
+// runScheduledJob starts a run for a tick of a cron initiator, after waiting
+// out the initiator's jitter and applying its overlap policy.
+func (r *Recurring) runScheduledJob(
+	job models.JobSpec,
+	initr models.Initiator,
+	queue *sync.Mutex,
+	removed <-chan struct{},
+) {
+	if isRemoved(removed) {
+		return
+	}
+	if initr.Jitter > 0 {
+		jitter := time.Duration(rand.Int63n(int64(initr.Jitter)))
+		if !r.sleep(jitter, removed) {
+			return
+		}
+	}
+
+	switch initr.OverlapPolicy {
+	case models.OverlapPolicySkip:
+		unfinished, err := r.store.AnyUnfinishedJobRuns(job.ID)
+		if err != nil {
+			logger.Errorw("Error checking for unfinished runs", "job", job.ID, "error", err)
+			return
+		} else if unfinished {
+			logger.Infow("Skipping scheduled run while a previous run is unfinished", "job", job.ID)
+			return
+		}
+	case models.OverlapPolicyQueue:
+		queue.Lock()
+		defer queue.Unlock()
+		if !r.waitForUnfinishedRuns(job, removed) {
+			return
+		}
+	}
+
+	if isRemoved(removed) {
+		return
+	}
+
+	_, err := ExecuteJob(job, initr, models.RunResult{}, nil, r.store)
+	if err != nil && !expectedRecurringScheduleJobError(err) {
+		logger.Errorw(err.Error())
+		return
+	}
+
+	if err := r.store.MarkFired(&initr, r.Clock.Now()); err != nil {
+		logger.Errorw("Error recording cron initiator fire time", "job", job.ID, "initiator", initr.ID, "error", err)
+	}
+}
+
+// overlapPollInterval is how often a queued scheduled run checks whether the
+// job's previous run has finished.
+const overlapPollInterval = time.Second
+
+// waitForUnfinishedRuns blocks until all of the job's runs have finished,
+// returning false if Recurring was stopped or the job removed first.
+func (r *Recurring) waitForUnfinishedRuns(job models.JobSpec, removed <-chan struct{}) bool {
+	for {
+		unfinished, err := r.store.AnyUnfinishedJobRuns(job.ID)
+		if err != nil {
+			logger.Errorw("Error checking for unfinished runs", "job", job.ID, "error", err)
+			return false
+		} else if !unfinished {
+			return true
 		}
+
+		logger.Debugw("Queueing scheduled run until the previous run finishes", "job", job.ID)
+		if !r.sleep(overlapPollInterval, removed) {
+			return false
+		}
+	}
+}
+
+func (r *Recurring) sleep(d time.Duration, removed <-chan struct{}) bool {
+	select {
+	case <-r.done:
+		return false
+	case <-removed:
+		return false
+	case <-r.store.Clock.After(d):
+		return true
+	}
+}
+
+// cronSchedule parses the initiator's cron schedule, evaluating it in the
+// initiator's time zone when one is given.
+func cronSchedule(initr models.Initiator) (cron.Schedule, error) {
+	schedule, err := cron.Parse(string(initr.Schedule))
+	if err != nil {
+		return nil, err
+	}
+	if initr.Timezone == "" {
+		return schedule, nil
+	}
+
+	location, err := time.LoadLocation(initr.Timezone)
+	if err != nil {
+		return nil, err
 	}
+	return locatedSchedule{Schedule: schedule, location: location}, nil
+}
+
+// locatedSchedule evaluates a cron schedule in a fixed time zone, so that
+// its wall clock times hold across daylight saving changes regardless of
+// the node's local time.
+type locatedSchedule struct {
+	cron.Schedule
+	location *time.Location
+}
+
+// Next returns the next activation time later than the given time.
+func (ls locatedSchedule) Next(t time.Time) time.Time {
+	return ls.Schedule.Next(t.In(ls.location))
 }
 
 // OneTime represents runs that are to be executed only once.
 type OneTime struct {
-	Store *store.Store
-	Clock Afterer
-	done  chan struct{}
+	Store    *store.Store
+	Clock    Afterer
+	done     chan struct{}
+	registry jobRegistry
 }
 
 // Start allocates a channel for the "done" field with an empty struct.
@@ -146,13 +441,45 @@ func (ot *OneTime) Start() error {
 	return nil
 }
 
-// AddJob runs the job at the time specified for the "runat" initiator.
+// AddJob runs the job at the time specified for the "runat" initiator,
+// applying the initiator's misfire policy if that time has already passed.
 func (ot *OneTime) AddJob(job models.JobSpec) {
-	for _, initr := range job.InitiatorsFor(models.InitiatorRunAt) {
-		go ot.RunJobAt(initr, job)
+	removed := ot.registry.add(job.ID)
+	for _, i := range job.InitiatorsFor(models.InitiatorRunAt) {
+		initr := i
+		if persisted, err := ot.Store.FindInitiator(initr.ID); err == nil {
+			initr = persisted
+		}
+		if initr.Ran {
+			continue
+		}
+
+		if initr.Time.Before(ot.Store.Clock.Now()) {
+			policy := initr.EffectiveMisfirePolicy()
+			logger.Infow("Applying misfire policy to missed runat",
+				"job", job.ID,
+				"initiator", initr.ID,
+				"policy", policy,
+				"time", initr.Time.ISO8601(),
+			)
+
+			if policy == models.MisfirePolicySkip {
+				if err := ot.Store.MarkRan(&initr); err != nil {
+					logger.Error(err.Error())
+				}
+				continue
+			}
+		}
+
+		go ot.runJobAt(initr, job, removed)
 	}
 }
 
+// RemoveJob stops waiting to run the job's "runat" initiators.
+func (ot *OneTime) RemoveJob(ID string) {
+	ot.registry.remove(ID)
+}
+
 // Stop closes the "done" field's channel.
 func (ot *OneTime) Stop() {
 	close(ot.done)
@@ -161,9 +488,15 @@ func (ot *OneTime) Stop() {
 // RunJobAt wait until the Stop() function has been called on the run
 // or the specified time for the run is after the present time.
 func (ot *OneTime) RunJobAt(initr models.Initiator, job models.JobSpec) {
+	ot.runJobAt(initr, job, ot.registry.get(job.ID))
+}
+
+func (ot *OneTime) runJobAt(initr models.Initiator, job models.JobSpec, removed <-chan struct{}) {
 	select {
 	case <-ot.done:
+	case <-removed:
 	case <-ot.Clock.After(initr.Time.DurationFromNow()):
+		initr.LastFiredAt = null.TimeFrom(ot.Store.Clock.Now())
 		if err := ot.Store.MarkRan(&initr); err != nil {
 			logger.Error(err.Error())
 			return
@@ -194,20 +527,93 @@ func expectedRecurringScheduleJobError(err error) bool {
 type Cron interface {
 	Start()
 	Stop()
-	AddFunc(string, func()) error
+	Schedule(cron.Schedule, cron.Job) CronEntryID
+	Remove(CronEntryID)
 }
 
+// CronEntryID identifies a function scheduled with Cron, so that it can be
+// removed from the schedule.
+type CronEntryID int
+
+type cronEntry struct {
+	schedule cron.Schedule
+	job      cron.Job
+}
+
+// chainlinkCron schedules functions with a cron.Cron, which has no way of
+// removing a scheduled function. Removing an entry therefore replaces the
+// cron.Cron with one scheduling the remaining entries, and stopping the
+// replaced one straight away.
 type chainlinkCron struct {
-	*cron.Cron
+	mutex    sync.Mutex
+	cron     *cron.Cron
+	retiring sync.WaitGroup
+	running  bool
+	nextID   CronEntryID
+	entries  map[CronEntryID]cronEntry
 }
 
 func newChainlinkCron() *chainlinkCron {
-	return &chainlinkCron{cron.New()}
+	return &chainlinkCron{
+		cron:    cron.New(),
+		entries: map[CronEntryID]cronEntry{},
+	}
 }
 
+func (cc *chainlinkCron) Start() {
+	cc.mutex.Lock()
+	defer cc.mutex.Unlock()
+	cc.running = true
+	cc.cron.Start()
+}
+
+// Stop stops scheduling functions and waits for the running ones to finish.
 func (cc *chainlinkCron) Stop() {
-	cc.Cron.Stop()
-	cc.Cron.Wait()
+	cc.mutex.Lock()
+	defer cc.mutex.Unlock()
+	cc.running = false
+	cc.cron.Stop()
+	cc.cron.Wait()
+	cc.retiring.Wait()
+}
+
+func (cc *chainlinkCron) Schedule(schedule cron.Schedule, job cron.Job) CronEntryID {
+	cc.mutex.Lock()
+	defer cc.mutex.Unlock()
+	cc.nextID++
+	cc.entries[cc.nextID] = cronEntry{schedule: schedule, job: job}
+	cc.cron.Schedule(schedule, job)
+	return cc.nextID
+}
+
+// Remove unschedules the entry, leaving a run of it already in progress to
+// finish.
+func (cc *chainlinkCron) Remove(id CronEntryID) {
+	cc.mutex.Lock()
+	defer cc.mutex.Unlock()
+	if _, ok := cc.entries[id]; !ok {
+		return
+	}
+	delete(cc.entries, id)
+
+	replacement := cron.New()
+	for _, entry := range cc.entries {
+		replacement.Schedule(entry.schedule, entry.job)
+	}
+	if cc.running {
+		cc.cron.Stop()
+		replacement.Start()
+	}
+
+	// Stop waits for the runs of the replaced cron.Cron still in progress,
+	// which is dropped once they finish.
+	retired := cc.cron
+	cc.retiring.Add(1)
+	go func() {
+		defer cc.retiring.Done()
+		retired.Wait()
+	}()
+	cc.cron = replacement
 }
 
 // Nower is an interface that fulfills the Now method,
@@ -1,14 +1,17 @@
 package services_test
 
 import (
+	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/mrwonko/cron"
 	"github.com/onsi/gomega"
 	"github.com/smartcontractkit/chainlink/internal/cltest"
 	"github.com/smartcontractkit/chainlink/services"
 	"github.com/smartcontractkit/chainlink/store/models"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/tevino/abool"
 	"go.uber.org/zap/zapcore"
 	null "gopkg.in/guregu/null.v3"
@@ -124,6 +127,188 @@ func TestRecurring_AddJob(t *testing.T) {
 	}
 }
 
+func TestRecurring_RemoveJob(t *testing.T) {
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	r := services.NewRecurring(store)
+	cron := cltest.NewMockCron()
+	r.Cron = cron
+	defer r.Stop()
+
+	j, _ := cltest.NewJobWithSchedule("* * * * *")
+	r.AddJob(j)
+	r.RemoveJob(j.ID)
+	assert.Len(t, cron.Entries, 0)
+
+	cron.RunEntries()
+	jobRuns, err := store.JobRunsFor(j.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(jobRuns))
+
+	r.AddJob(j)
+	r.AddJob(j)
+	assert.Len(t, cron.Entries, 1, "re-adding a job should replace its entries")
+	cron.RunEntries()
+	jobRuns, err = store.JobRunsFor(j.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(jobRuns), "should only run the entry of the job's latest registration")
+}
+
+func TestChainlinkCron_Remove(t *testing.T) {
+	t.Parallel()
+
+	c := services.ExportedNewChainlinkCron()
+	c.Start()
+	defer c.Stop()
+
+	schedule, err := cron.Parse("* * * * * *")
+	require.NoError(t, err)
+	var kept, removed int32
+	c.Schedule(schedule, cron.FuncJob(func() { atomic.AddInt32(&kept, 1) }))
+	id := c.Schedule(schedule, cron.FuncJob(func() { atomic.AddInt32(&removed, 1) }))
+	c.Remove(id)
+
+	gomega.NewGomegaWithT(t).Eventually(func() int32 {
+		return atomic.LoadInt32(&kept)
+	}, 3*time.Second).Should(gomega.BeNumerically(">", 0))
+	assert.Equal(t, int32(0), atomic.LoadInt32(&removed))
+}
+
+func TestRecurring_AddJob_Timezone(t *testing.T) {
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	r := services.NewRecurring(store)
+	cron := cltest.NewMockCron()
+	r.Cron = cron
+	defer r.Stop()
+
+	j, _ := cltest.NewJobWithSchedule("0 30 9 * * *")
+	j.Initiators[0].Timezone = "America/New_York"
+	r.AddJob(j)
+
+	require.Len(t, cron.Entries, 1)
+	schedule := cron.Entries[0].Schedule
+
+	winter := schedule.Next(cltest.ParseISO8601("2019-01-10T00:00:00Z"))
+	assert.True(t, cltest.ParseISO8601("2019-01-10T14:30:00Z").Equal(winter))
+
+	summer := schedule.Next(cltest.ParseISO8601("2019-03-11T00:00:00Z"))
+	assert.True(t, cltest.ParseISO8601("2019-03-11T13:30:00Z").Equal(summer))
+}
+
+func TestRecurring_AddJob_OverlapPolicySkip(t *testing.T) {
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	r := services.NewRecurring(store)
+	cron := cltest.NewMockCron()
+	r.Cron = cron
+	defer r.Stop()
+
+	j, initr := cltest.NewJobWithSchedule("* * * * *")
+	j.Initiators[0].OverlapPolicy = models.OverlapPolicySkip
+	require.NoError(t, store.SaveJob(&j))
+
+	pendingRun := j.NewRun(initr)
+	pendingRun.Status = models.RunStatusPendingBridge
+	require.NoError(t, store.SaveJobRun(&pendingRun))
+
+	r.AddJob(j)
+	cron.RunEntries()
+
+	jobRuns, err := store.JobRunsFor(j.ID)
+	assert.NoError(t, err)
+	assert.Len(t, jobRuns, 1)
+
+	pendingRun.Status = models.RunStatusCompleted
+	require.NoError(t, store.SaveJobRun(&pendingRun))
+	cron.RunEntries()
+
+	jobRuns, err = store.JobRunsFor(j.ID)
+	assert.NoError(t, err)
+	assert.Len(t, jobRuns, 2)
+}
+
+func TestRecurring_AddJob_MisfirePolicy(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		policy   string
+		limit    uint
+		wantRuns int
+	}{
+		{"default", "", 0, 0},
+		{"skip", models.MisfirePolicySkip, 0, 0},
+		{"fire once", models.MisfirePolicyFireOnce, 0, 1},
+		{"fire all", models.MisfirePolicyFireAll, 0, 3},
+		{"fire all up to limit", models.MisfirePolicyFireAll, 2, 2},
+	}
+
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+	clock := cltest.UseSettableClock(store)
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			lastFired := time.Now().UTC().Truncate(time.Hour).Add(-3 * time.Hour)
+			createdAt := models.Time{Time: lastFired.Add(-time.Hour)}
+
+			j, _ := cltest.NewJobWithSchedule("0 0 * * * *")
+			j.CreatedAt = createdAt
+			j.UpdatedAt = createdAt
+			j.Initiators[0].Timezone = "UTC"
+			j.Initiators[0].MisfirePolicy = test.policy
+			j.Initiators[0].MisfireLimit = test.limit
+			require.NoError(t, store.SaveJob(&j))
+			require.NoError(t, store.MarkFired(&j.Initiators[0], lastFired))
+
+			// Three hourly ticks are missed while the node is down
+			clock.SetTime(lastFired.Add(3*time.Hour + 30*time.Minute))
+			r := services.NewRecurring(store)
+			r.Cron = cltest.NewMockCron()
+			defer r.Stop()
+
+			r.AddJob(j)
+
+			if test.wantRuns > 0 {
+				cltest.WaitForRuns(t, j, store, test.wantRuns)
+			}
+			gomega.NewGomegaWithT(t).Consistently(func() int {
+				runs, err := store.JobRunsFor(j.ID)
+				assert.NoError(t, err)
+				return len(runs)
+			}, time.Second).Should(gomega.Equal(test.wantRuns))
+		})
+	}
+}
+
+func TestOneTime_AddJob_MisfirePolicySkip(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	ot := services.OneTime{
+		Clock: store.Clock,
+		Store: store,
+	}
+
+	j, _ := cltest.NewJobWithRunAtInitiator(time.Now().Add(-time.Hour))
+	j.Initiators[0].MisfirePolicy = models.MisfirePolicySkip
+	require.NoError(t, store.SaveJob(&j))
+
+	ot.AddJob(j)
+
+	cltest.WaitForRuns(t, j, store, 0)
+	initr, err := store.FindInitiator(j.Initiators[0].ID)
+	require.NoError(t, err)
+	assert.True(t, initr.Ran)
+}
+
 func TestOneTime_AddJob(t *testing.T) {
 	nullTime := cltest.NullTime(nil)
 	pastTime := cltest.NullTime("2000-01-01T00:00:00.000Z")
@@ -209,6 +394,41 @@ func TestOneTime_RunJobAt_StopJobBeforeExecution(t *testing.T) {
 	assert.Equal(t, 0, len(jobRuns))
 }
 
+func TestOneTime_RemoveJob(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	ot := services.OneTime{
+		Clock: &cltest.NeverClock{},
+		Store: store,
+	}
+	ot.Start()
+	defer ot.Stop()
+	j, initr := cltest.NewJobWithRunAtInitiator(time.Now().Add(time.Hour))
+	assert.Nil(t, store.SaveJob(&j))
+
+	finished := abool.New()
+	go func() {
+		ot.RunJobAt(initr, j)
+		finished.Set()
+	}()
+
+	gomega.NewGomegaWithT(t).Consistently(func() bool {
+		return finished.IsSet()
+	}).Should(gomega.Equal(false))
+
+	ot.RemoveJob(j.ID)
+
+	gomega.NewGomegaWithT(t).Eventually(func() bool {
+		return finished.IsSet()
+	}).Should(gomega.Equal(true))
+	jobRuns, err := store.JobRunsFor(j.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(jobRuns))
+}
+
 func TestOneTime_RunJobAt_ExecuteLateJob(t *testing.T) {
 	t.Parallel()
 
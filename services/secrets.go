@@ -0,0 +1,101 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/smartcontractkit/chainlink/store"
+	"github.com/smartcontractkit/chainlink/store/models"
+	"github.com/smartcontractkit/chainlink/store/orm"
+)
+
+// ErrSecretInRunData is returned when the data a run was requested with
+// references a secret, so that requesters cannot read secrets into the
+// params of a task.
+var ErrSecretInRunData = errors.New("secrets can only be referenced from the params of a job spec")
+
+// SaveSecret encrypts the value of the secret and stores it under its name,
+// replacing any secret of the same name.
+func SaveSecret(sr models.SecretRequest, store *store.Store) (models.Secret, error) {
+	if err := models.ValidateSecretName(sr.Name); err != nil {
+		return models.Secret{}, err
+	}
+	if sr.Value == "" {
+		return models.Secret{}, models.NewValidationError("secret value must not be empty")
+	}
+
+	ciphertext, err := store.KeyStore.EncryptSecret([]byte(sr.Value))
+	if err != nil {
+		return models.Secret{}, err
+	}
+	secret := models.Secret{
+		Name:       sr.Name,
+		Ciphertext: ciphertext,
+		CreatedAt:  store.Clock.Now(),
+	}
+	return secret, store.SaveSecret(&secret)
+}
+
+// mergeOverrides merges the overrides of the run into the params of the task.
+// The params of a task that references a secret are left as the job spec
+// set them, so that requesters cannot send its secrets elsewhere by
+// overriding the url or path it uses them with.
+func mergeOverrides(run *models.JobRun, task models.TaskSpec) (models.TaskSpec, error) {
+	if len(models.SecretReferences(task.Params)) > 0 {
+		return task, nil
+	}
+
+	var err error
+	task.Params, err = task.Params.Merge(run.Overrides.Data)
+	return task, err
+}
+
+// resolveTaskSecrets returns a copy of the task with the secrets referenced
+// in its params decrypted, along with their values so that they can be
+// redacted. The task run keeps the references, so the values only ever live
+// in memory.
+func resolveTaskSecrets(run *models.JobRun, task models.TaskSpec, store *store.Store) (models.TaskSpec, []string, error) {
+	if len(models.SecretReferences(run.Overrides.Data)) > 0 {
+		return task, nil, ErrSecretInRunData
+	}
+
+	params, values, err := models.ResolveSecrets(task.Params, func(name string) (string, error) {
+		return secretValue(name, store)
+	})
+	if err != nil {
+		return task, nil, err
+	}
+	task.Params = params
+	return task, values, nil
+}
+
+func secretValue(name string, store *store.Store) (string, error) {
+	secret, err := store.FindSecret(name)
+	if err == orm.ErrorNotFound {
+		return "", fmt.Errorf("no secret named %q", name)
+	} else if err != nil {
+		return "", err
+	}
+
+	value, err := store.KeyStore.DecryptSecret(secret.Ciphertext)
+	if err != nil {
+		return "", err
+	}
+	return string(value), nil
+}
+
+// redactResult replaces the values of secrets in the data and error message
+// of the result.
+func redactResult(result models.RunResult, values []string) models.RunResult {
+	if len(values) == 0 {
+		return result
+	}
+
+	if data, err := models.ParseJSON([]byte(models.RedactSecrets(result.Data.String(), values))); err == nil {
+		result.Data = data
+	}
+	if result.ErrorMessage.Valid {
+		result.ErrorMessage.String = models.RedactSecrets(result.ErrorMessage.String, values)
+	}
+	return result
+}
@@ -0,0 +1,163 @@
+package services_test
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/internal/cltest"
+	"github.com/smartcontractkit/chainlink/services"
+	"github.com/smartcontractkit/chainlink/store/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExecuteRun_ResolvesSecrets(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+	_, err := store.KeyStore.NewAccount(cltest.Password)
+	require.NoError(t, err)
+
+	_, err = services.SaveSecret(models.SecretRequest{Name: "api_key", Value: "s3cr3t"}, store)
+	require.NoError(t, err)
+
+	var receivedKey string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		receivedKey = r.URL.Query().Get("key")
+		io.WriteString(w, "echo "+receivedKey)
+	}))
+	defer server.Close()
+
+	job, initr := cltest.NewJobWithWebInitiator()
+	job.Tasks = []models.TaskSpec{
+		cltest.NewTask("httpget", fmt.Sprintf(`{"get":"%s?key={{secret \"api_key\"}}"}`, server.URL)),
+	}
+	require.NoError(t, store.SaveJob(&job))
+	run := job.NewRun(initr)
+	run.Status = models.RunStatusInProgress
+	require.NoError(t, store.SaveJobRun(&run))
+
+	_, err = services.ExportedExecuteRunAtBlock(&run, store, models.RunResult{})
+	require.NoError(t, err)
+
+	run, err = store.FindJobRun(run.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "s3cr3t", receivedKey)
+	assert.Equal(t, models.RunStatusCompleted, run.Status)
+	assert.Contains(t, run.TaskRuns[0].Task.Params.String(), `{{secret \"api_key\"}}`)
+	assert.NotContains(t, run.TaskRuns[0].Result.Data.String(), "s3cr3t")
+	assert.Equal(t, "echo "+models.RedactedSecret, run.Result.Data.Get("value").String())
+}
+
+func TestExecuteRun_SecretInRunData(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+	_, err := store.KeyStore.NewAccount(cltest.Password)
+	require.NoError(t, err)
+
+	_, err = services.SaveSecret(models.SecretRequest{Name: "api_key", Value: "s3cr3t"}, store)
+	require.NoError(t, err)
+
+	job, initr := cltest.NewJobWithWebInitiator()
+	job.Tasks = []models.TaskSpec{cltest.NewTask("noop")}
+	require.NoError(t, store.SaveJob(&job))
+	run := job.NewRun(initr)
+	run.Status = models.RunStatusInProgress
+	run.Overrides.Data = cltest.JSONFromString(`{"url":"https://example.com?key={{secret \"api_key\"}}"}`)
+	require.NoError(t, store.SaveJobRun(&run))
+
+	_, err = services.ExportedExecuteRunAtBlock(&run, store, models.RunResult{})
+	require.NoError(t, err)
+
+	run, err = store.FindJobRun(run.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.RunStatusErrored, run.Status)
+	assert.Equal(t, services.ErrSecretInRunData.Error(), run.Result.Error())
+}
+
+func TestSaveSecret_Validation(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+	_, err := store.KeyStore.NewAccount(cltest.Password)
+	require.NoError(t, err)
+
+	_, err = services.SaveSecret(models.SecretRequest{Name: "bad name", Value: "value"}, store)
+	assert.IsType(t, &models.ValidationError{}, err)
+	_, err = services.SaveSecret(models.SecretRequest{Name: "empty", Value: ""}, store)
+	assert.IsType(t, &models.ValidationError{}, err)
+
+	secret, err := services.SaveSecret(models.SecretRequest{Name: "api_key", Value: "s3cr3t"}, store)
+	require.NoError(t, err)
+	assert.NotContains(t, string(secret.Ciphertext), "s3cr3t")
+
+	found, err := store.FindSecret("api_key")
+	require.NoError(t, err)
+	assert.Equal(t, secret.Ciphertext, found.Ciphertext)
+}
+
+func TestValidateJob_UnknownSecret(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	job, _ := cltest.NewJobWithWebInitiator()
+	job.Tasks = []models.TaskSpec{
+		cltest.NewTask("httpget", `{"get":"https://example.com?key={{secret \"api_key\"}}"}`),
+	}
+	err := services.ValidateJob(job, store)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `unknown secret "api_key"`)
+}
+
+func TestExecuteRun_OverridesIgnoredOnSecretTask(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+	_, err := store.KeyStore.NewAccount(cltest.Password)
+	require.NoError(t, err)
+
+	_, err = services.SaveSecret(models.SecretRequest{Name: "api_key", Value: "s3cr3t"}, store)
+	require.NoError(t, err)
+
+	var receivedKey string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		receivedKey = r.URL.Query().Get("key")
+		io.WriteString(w, "ok")
+	}))
+	defer server.Close()
+
+	var leaked bool
+	requester := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		leaked = true
+	}))
+	defer requester.Close()
+
+	job, initr := cltest.NewJobWithWebInitiator()
+	job.Tasks = []models.TaskSpec{
+		cltest.NewTask("httpget", fmt.Sprintf(`{"get":"%s?key={{secret \"api_key\"}}"}`, server.URL)),
+	}
+	require.NoError(t, store.SaveJob(&job))
+	run := job.NewRun(initr)
+	run.Status = models.RunStatusInProgress
+	run.Overrides.Data = cltest.JSONFromString(fmt.Sprintf(`{"get":"%s"}`, requester.URL))
+	require.NoError(t, store.SaveJobRun(&run))
+
+	_, err = services.ExportedExecuteRunAtBlock(&run, store, models.RunResult{})
+	require.NoError(t, err)
+
+	run, err = store.FindJobRun(run.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.RunStatusCompleted, run.Status)
+	assert.Equal(t, "s3cr3t", receivedKey)
+	assert.False(t, leaked, "overrides cannot change the params of a task referencing a secret")
+}
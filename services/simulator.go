@@ -0,0 +1,98 @@
+package services
+
+import (
+	"fmt"
+
+	"github.com/smartcontractkit/chainlink/adapters"
+	"github.com/smartcontractkit/chainlink/logger"
+	"github.com/smartcontractkit/chainlink/store"
+	"github.com/smartcontractkit/chainlink/store/models"
+)
+
+// SimulateJob executes the tasks of the job in memory against the passed
+// input, without saving a run. Adapters calling out to the network are
+// performed as usual, while adapters with side effects on the block chain
+// only report what they would have done. The simulation stops at the first
+// task that does not complete.
+func SimulateJob(
+	job models.JobSpec,
+	input models.RunResult,
+	opts adapters.SimulationOptions,
+	store *store.Store,
+) (models.JobSimulation, error) {
+	run := job.NewRun(models.Initiator{Type: models.InitiatorWeb})
+	input.JobRunID = run.ID
+	run.Overrides = input
+	run = run.ApplyResult(input)
+	run.Status = models.RunStatusInProgress
+
+	simulation := models.JobSimulation{ID: run.ID}
+	logger.Debugw("Simulating job", run.ForLogger()...)
+	for index, ok := nextSimulatedTaskRunIndex(run); ok; index, ok = nextSimulatedTaskRunIndex(run) {
+		taskRun := run.TaskRuns[index]
+		taskInput, result, err := simulateTask(&run, &taskRun, opts, store)
+		if err != nil {
+			return simulation, err
+		}
+
+		simulation.Tasks = append(simulation.Tasks, models.TaskSimulation{
+			Task:   taskRun.Task,
+			Input:  taskInput,
+			Output: result,
+		})
+		run.TaskRuns[index] = taskRun.ApplyResult(result)
+		run = run.ApplyResult(result)
+		if !result.Status.Completed() {
+			break
+		}
+	}
+
+	simulation.Status = run.Status
+	simulation.Result = run.Result
+	return simulation, nil
+}
+
+func nextSimulatedTaskRunIndex(run models.JobRun) (int, bool) {
+	if run.IsGraph() {
+		indexes := run.ReadyTaskRunIndexes()
+		if len(indexes) == 0 {
+			return 0, false
+		}
+		return indexes[0], true
+	}
+	return run.NextTaskRunIndex()
+}
+
+func simulateTask(
+	run *models.JobRun,
+	taskRun *models.TaskRun,
+	opts adapters.SimulationOptions,
+	store *store.Store,
+) (models.RunResult, models.RunResult, error) {
+	var err error
+	if taskRun.Task, err = mergeOverrides(run, taskRun.Task); err != nil {
+		return models.RunResult{}, models.RunResult{}, err
+	}
+
+	input, err := prepareTaskInput(run, taskRun)
+	if err != nil {
+		return input, models.RunResult{}, err
+	}
+
+	task, secrets, err := resolveTaskSecrets(run, taskRun.Task, store)
+	if err != nil {
+		return input, input.WithError(err), nil
+	}
+
+	adapter, err := adapters.For(task, store)
+	if err != nil {
+		return input, redactResult(input.WithError(err), secrets), nil
+	}
+
+	if simulator, ok := adapter.BaseAdapter.(adapters.Simulator); ok {
+		return input, redactResult(simulator.Simulate(input, store, opts), secrets), nil
+	}
+	result := redactResult(performWithTimeout(adapter, input, taskRun.Task.Timeout.Duration(), store), secrets)
+	logger.Debugw(fmt.Sprintf("Simulated task %s", taskRun.Task.Type), "task", taskRun.ID, "result", result.Status)
+	return input, result, nil
+}
@@ -0,0 +1,68 @@
+package services_test
+
+import (
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/adapters"
+	"github.com/smartcontractkit/chainlink/internal/cltest"
+	"github.com/smartcontractkit/chainlink/services"
+	"github.com/smartcontractkit/chainlink/store/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSimulateJob(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	job, _ := cltest.NewJobWithWebInitiator()
+	job.Tasks = []models.TaskSpec{
+		{Type: adapters.TaskTypeMultiply, Params: cltest.JSONFromString(`{"times":100}`)},
+		{Type: adapters.TaskTypeSleep},
+		{
+			Type: adapters.TaskTypeEthTx,
+			Params: cltest.JSONFromString(`{
+				"address":"0xdfcfc2b9200dbb10952c2b7cce60fc7260e03c6f",
+				"functionSelector":"0xb3f98adc"
+			}`),
+		},
+	}
+
+	input := models.RunResult{Data: cltest.JSONFromString(`{"value":"1.5"}`)}
+	sim, err := services.SimulateJob(job, input, adapters.SimulationOptions{}, store)
+	require.NoError(t, err)
+
+	assert.Equal(t, models.RunStatusCompleted, sim.Status)
+	require.Len(t, sim.Tasks, 3)
+	assert.Equal(t, "1.5", sim.Tasks[0].Input.Data.Get("value").String())
+	assert.Equal(t, "150", sim.Tasks[0].Output.Data.Get("value").String())
+	assert.Equal(t, models.RunStatusCompleted, sim.Tasks[1].Output.Status)
+	assert.Equal(t,
+		"0xb3f98adc0000000000000000000000000000000000000000000000000000000000000150",
+		sim.Result.Data.Get("value").String())
+
+	count, err := store.Count(&models.JobRun{})
+	require.NoError(t, err)
+	assert.Equal(t, 0, count)
+}
+
+func TestSimulateJob_StopsAtPendingTask(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	job, _ := cltest.NewJobWithWebInitiator()
+	job.Tasks = []models.TaskSpec{
+		{Type: adapters.TaskTypeNoOpPend},
+		{Type: adapters.TaskTypeNoOp},
+	}
+
+	sim, err := services.SimulateJob(job, models.RunResult{}, adapters.SimulationOptions{}, store)
+	require.NoError(t, err)
+
+	assert.Equal(t, models.RunStatusPendingConfirmations, sim.Status)
+	assert.Len(t, sim.Tasks, 1)
+}
@@ -4,12 +4,14 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"sync"
 	"time"
 
 	ethereum "github.com/ethereum/go-ethereum"
 	"github.com/smartcontractkit/chainlink/logger"
 	strpkg "github.com/smartcontractkit/chainlink/store"
 	"github.com/smartcontractkit/chainlink/store/models"
+	"github.com/smartcontractkit/chainlink/store/orm"
 	"github.com/smartcontractkit/chainlink/store/presenters"
 	"github.com/smartcontractkit/chainlink/utils"
 	"go.uber.org/multierr"
@@ -27,9 +29,16 @@ type JobSubscription struct {
 }
 
 // StartJobSubscription constructs a JobSubscription which listens for and
-// tracks event logs corresponding to the specified job. Ignores any errors if
-// there is at least one successful subscription to an initiator log.
-func StartJobSubscription(job models.JobSpec, head *models.IndexableBlockNumber, store *strpkg.Store) (JobSubscription, error) {
+// tracks event logs corresponding to the specified job, and adds it to the
+// shared subscription to pending transactions if it has "pendingtx"
+// initiators. Ignores any errors if there is at least one successful
+// subscription to an initiator.
+func StartJobSubscription(
+	job models.JobSpec,
+	head *models.IndexableBlockNumber,
+	store *strpkg.Store,
+	pendingTxs *PendingTxSubscription,
+) (JobSubscription, error) {
 	var merr error
 	var unsubscribers []Unsubscriber
 
@@ -48,6 +57,15 @@ func StartJobSubscription(job models.JobSpec, head *models.IndexableBlockNumber,
 		}
 	}
 
+	if job.IsPendingTxInitiated() {
+		unsubscriber, err := pendingTxs.AddJob(job)
+		if err == nil {
+			unsubscribers = append(unsubscribers, unsubscriber)
+		} else {
+			merr = multierr.Append(merr, err)
+		}
+	}
+
 	if len(unsubscribers) == 0 {
 		return JobSubscription{}, multierr.Append(
 			merr, errors.New(
@@ -130,25 +148,51 @@ func loggerLogListening(initr models.Initiator, blockNumber *big.Int) {
 // ReceiveLogRequest parses the log and runs the job indicated by a RunLog or
 // ServiceAgreementExecutionLog. (Both log events have the same format.)
 func ReceiveLogRequest(store *strpkg.Store, le models.LogRequest) {
+	if _, err := receiveLogRequest(store, le); err != nil {
+		logger.Errorw(err.Error(), le.ForLogger()...)
+	}
+}
+
+// receiveLogRequest returns the run created for the log request, or nil if
+// the log was not a valid request for the job, had already initiated a run or
+// was removed by a reorg.
+func receiveLogRequest(store *strpkg.Store, le models.LogRequest) (*models.JobRun, error) {
 	if !le.Validate() {
-		return
+		return nil, nil
+	}
+
+	if le.GetLog().Removed {
+		return nil, FlagRemovedLogRun(le, store)
 	}
 
 	le.ToDebug()
 	data, err := le.JSON()
 	if err != nil {
-		logger.Errorw(err.Error(), le.ForLogger()...)
-		return
+		return nil, err
 	}
 
-	runJob(store, le, data)
+	return runJob(store, le, data)
 }
 
-func runJob(store *strpkg.Store, le models.LogRequest, data models.JSON) {
+// logRunsMutex keeps a log delivered by a subscription and a backfill at the
+// same time from initiating two runs.
+var logRunsMutex sync.Mutex
+
+func runJob(store *strpkg.Store, le models.LogRequest, data models.JSON) (*models.JobRun, error) {
+	logRunsMutex.Lock()
+	defer logRunsMutex.Unlock()
+
+	key := models.LogKey(le)
+	if run, err := store.FindJobRunByLogKey(key); err == nil {
+		logger.Infow("Skipping log that already initiated a run", run.ForLogger("log_key", key)...)
+		return nil, nil
+	} else if err != orm.ErrorNotFound {
+		return nil, err
+	}
+
 	payment, err := le.ContractPayment()
 	if err != nil {
-		logger.Errorw(err.Error(), le.ForLogger()...)
-		return
+		return nil, err
 	}
 
 	input := models.RunResult{
@@ -160,11 +204,7 @@ func runJob(store *strpkg.Store, le models.LogRequest, data models.JSON) {
 		logger.Errorw(err.Error(), le.ForLogger()...)
 	}
 
-	currentHead := le.ToIndexableBlockNumber().Number
-	_, err = ExecuteJob(le.GetJobSpec(), le.GetInitiator(), input, &currentHead, store)
-	if err != nil {
-		logger.Errorw(err.Error(), le.ForLogger()...)
-	}
+	return ExecuteJobWithLog(le, input, store)
 }
 
 // ManagedSubscription encapsulates the connecting, backfilling, and clean up of an
@@ -10,6 +10,7 @@ import (
 	strpkg "github.com/smartcontractkit/chainlink/store"
 	"github.com/smartcontractkit/chainlink/store/models"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestServices_NewInitiatorSubscription_BackfillLogs(t *testing.T) {
@@ -88,3 +89,61 @@ func TestServices_NewInitiatorSubscription_PreventsDoubleDispatch(t *testing.T)
 	g := gomega.NewGomegaWithT(t)
 	g.Eventually(func() int32 { return atomic.LoadInt32(&count) }).Should(gomega.Equal(int32(2)))
 }
+
+func TestServices_ReceiveLogRequest_SkipsDuplicateLogs(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	job, initr := cltest.NewJobWithRunLogInitiator()
+	require.NoError(t, store.SaveJob(&job))
+
+	log := cltest.NewRunLog(job.ID, initr.Address, cltest.NewAddress(), 1, `{}`)
+	log.TxHash = cltest.NewHash()
+	log.BlockHash = cltest.NewHash()
+	services.ReceiveLogRequest(store, models.InitiatorLogEvent{JobSpec: job, Initiator: initr, Log: log}.LogRequest())
+
+	// The same request delivered again in another block after a reorg
+	log.BlockHash = cltest.NewHash()
+	log.BlockNumber = 2
+	services.ReceiveLogRequest(store, models.InitiatorLogEvent{JobSpec: job, Initiator: initr, Log: log}.LogRequest())
+
+	runs, err := store.JobRunsFor(job.ID)
+	require.NoError(t, err)
+	require.Len(t, runs, 1)
+	assert.NotEmpty(t, runs[0].LogKey)
+
+	// Another request in the same transaction
+	other := cltest.NewRunLog(job.ID, initr.Address, cltest.NewAddress(), 1, `{}`)
+	other.TxHash = log.TxHash
+	other.Index = log.Index + 1
+	services.ReceiveLogRequest(store, models.InitiatorLogEvent{JobSpec: job, Initiator: initr, Log: other}.LogRequest())
+
+	count, err := store.JobRunsCountFor(job.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+}
+
+func TestServices_ReceiveLogRequest_FlagsRunOfRemovedLog(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	job, initr := cltest.NewJobWithRunLogInitiator()
+	require.NoError(t, store.SaveJob(&job))
+
+	log := cltest.NewRunLog(job.ID, initr.Address, cltest.NewAddress(), 1, `{}`)
+	log.TxHash = cltest.NewHash()
+	services.ReceiveLogRequest(store, models.InitiatorLogEvent{JobSpec: job, Initiator: initr, Log: log}.LogRequest())
+
+	log.Removed = true
+	services.ReceiveLogRequest(store, models.InitiatorLogEvent{JobSpec: job, Initiator: initr, Log: log}.LogRequest())
+
+	runs, err := store.JobRunsFor(job.ID)
+	require.NoError(t, err)
+	require.Len(t, runs, 1)
+	assert.True(t, runs[0].LogRemoved)
+	assert.Len(t, runs[0].Notes, 1)
+}
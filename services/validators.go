@@ -30,6 +30,12 @@ func ValidateJob(j models.JobSpec, store *store.Store) error {
 			fe.Merge(err)
 		}
 	}
+	if err := validateTaskGraph(j); err != nil {
+		fe.Merge(err)
+	}
+	if err := validateJobChain(j, store); err != nil {
+		fe.Merge(err)
+	}
 	return fe.CoerceEmptyToNil()
 }
 
@@ -58,6 +64,10 @@ func ValidateInitiator(i models.Initiator, j models.JobSpec) error {
 		return validateCronInitiator(i)
 	case models.InitiatorServiceAgreementExecutionLog:
 		return validateServiceAgreementInitiator(i, j)
+	case models.InitiatorRunCompletion:
+		return validateRunCompletionInitiator(i, j)
+	case models.InitiatorPendingTransaction:
+		return validatePendingTxInitiator(i)
 	case models.InitiatorWeb:
 		fallthrough
 	case models.InitiatorRunLog:
@@ -78,14 +88,45 @@ func validateRunAtInitiator(i models.Initiator, j models.JobSpec) error {
 	} else if j.EndAt.Valid && i.Time.Unix() > j.EndAt.Time.Unix() {
 		fe.Add("RunAt time must be before job's EndAt")
 	}
+	if err := validateMisfirePolicy(i); err != nil {
+		fe.Merge(err)
+	}
 	return fe.CoerceEmptyToNil()
 }
 
 func validateCronInitiator(i models.Initiator) error {
+	fe := models.NewJSONAPIErrors()
 	if i.Schedule == "" {
-		return models.NewJSONAPIErrorsWith("Schedule must have a cron")
+		fe.Add("Schedule must have a cron")
+	}
+	if i.Timezone != "" {
+		if _, err := time.LoadLocation(i.Timezone); err != nil {
+			fe.Add(fmt.Sprintf("Timezone %s is not a valid IANA time zone", i.Timezone))
+		}
+	}
+	if i.Jitter < 0 {
+		fe.Add("Jitter cannot be negative")
+	}
+	switch i.OverlapPolicy {
+	case "", models.OverlapPolicyAllow, models.OverlapPolicySkip, models.OverlapPolicyQueue:
+	default:
+		fe.Add(fmt.Sprintf("OverlapPolicy must be one of %s, %s or %s",
+			models.OverlapPolicyAllow, models.OverlapPolicySkip, models.OverlapPolicyQueue))
+	}
+	if err := validateMisfirePolicy(i); err != nil {
+		fe.Merge(err)
+	}
+	return fe.CoerceEmptyToNil()
+}
+
+func validateMisfirePolicy(i models.Initiator) error {
+	switch i.MisfirePolicy {
+	case "", models.MisfirePolicyFireOnce, models.MisfirePolicyFireAll, models.MisfirePolicySkip:
+		return nil
+	default:
+		return models.NewJSONAPIErrorsWith(fmt.Sprintf("MisfirePolicy must be one of %s, %s or %s",
+			models.MisfirePolicyFireOnce, models.MisfirePolicyFireAll, models.MisfirePolicySkip))
 	}
-	return nil
 }
 
 func validateServiceAgreementInitiator(i models.Initiator, j models.JobSpec) error {
@@ -96,9 +137,146 @@ func validateServiceAgreementInitiator(i models.Initiator, j models.JobSpec) err
 	return fe.CoerceEmptyToNil()
 }
 
+func validateRunCompletionInitiator(i models.Initiator, j models.JobSpec) error {
+	fe := models.NewJSONAPIErrors()
+	if i.ParentJobID == "" {
+		fe.Add("RunCompletion must have a parentJobId")
+	}
+	for _, status := range i.ParentStatuses {
+		if !status.Finished() {
+			fe.Add(fmt.Sprintf("RunCompletion parentStatuses must be %s, %s, %s or %s, got %s",
+				models.RunStatusCompleted, models.RunStatusErrored, models.RunStatusCancelled, models.RunStatusExpired, status))
+		}
+	}
+	return fe.CoerceEmptyToNil()
+}
+
+func validatePendingTxInitiator(i models.Initiator) error {
+	fe := models.NewJSONAPIErrors()
+	if len(i.ToAddresses) == 0 && len(i.FromAddresses) == 0 {
+		fe.Add("PendingTx must have at least one toAddresses or fromAddresses entry")
+	}
+	return fe.CoerceEmptyToNil()
+}
+
+// validateJobChain walks up the jobs that trigger the passed job through run
+// completion initiators, ensuring that they exist and never lead back to
+// the job itself.
+func validateJobChain(j models.JobSpec, store *store.Store) error {
+	fe := models.NewJSONAPIErrors()
+	visited := map[string]bool{j.ID: true}
+	parents := j.InitiatorsFor(models.InitiatorRunCompletion)
+	for len(parents) > 0 {
+		initr := parents[0]
+		parents = parents[1:]
+		if initr.ParentJobID == "" {
+			continue
+		} else if initr.ParentJobID == j.ID {
+			fe.Add(fmt.Sprintf("RunCompletion chain from job %s cannot lead back to itself", j.ID))
+			continue
+		} else if visited[initr.ParentJobID] {
+			continue
+		}
+		visited[initr.ParentJobID] = true
+
+		parent, err := store.FindJob(initr.ParentJobID)
+		if err != nil {
+			fe.Add(fmt.Sprintf("RunCompletion parent job %s not found", initr.ParentJobID))
+			continue
+		}
+		parents = append(parents, parent.InitiatorsFor(models.InitiatorRunCompletion)...)
+	}
+	return fe.CoerceEmptyToNil()
+}
+
+// validateTaskGraph ensures that task IDs are unique, and that the tasks
+// depended on exist and never lead back to the dependent task.
+func validateTaskGraph(j models.JobSpec) error {
+	fe := models.NewJSONAPIErrors()
+	tasks := map[string]models.TaskSpec{}
+	for _, task := range j.Tasks {
+		if task.ID == "" {
+			continue
+		}
+		if _, present := tasks[task.ID]; present {
+			fe.Add(fmt.Sprintf("Task ID %v is not unique", task.ID))
+		}
+		tasks[task.ID] = task
+	}
+
+	for _, task := range j.Tasks {
+		for _, id := range task.DependsOn {
+			if _, present := tasks[id]; !present {
+				fe.Add(fmt.Sprintf("Task %v depends on unknown task %v", task.Type, id))
+			}
+		}
+	}
+	if len(fe.Errors) > 0 {
+		return fe
+	}
+
+	// Depth first search for a task reachable from its own dependencies
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := map[string]int{}
+	var visit func(id string) bool
+	visit = func(id string) bool {
+		switch state[id] {
+		case visiting:
+			return false
+		case visited:
+			return true
+		}
+		state[id] = visiting
+		for _, parent := range tasks[id].DependsOn {
+			if !visit(parent) {
+				return false
+			}
+		}
+		state[id] = visited
+		return true
+	}
+	for _, task := range j.Tasks {
+		if len(task.DependsOn) > 0 && state[task.ID] == unvisited && !visit(task.ID) {
+			fe.Add(fmt.Sprintf("Task %v is part of a dependency cycle", task.ID))
+			break
+		}
+	}
+	return fe.CoerceEmptyToNil()
+}
+
 func validateTask(task models.TaskSpec, store *store.Store) error {
-	_, err := adapters.For(task, store)
-	return err
+	if _, err := adapters.For(task, store); err != nil {
+		return err
+	}
+
+	fe := models.NewJSONAPIErrors()
+	if task.Timeout < 0 {
+		fe.Add(fmt.Sprintf("Task %v timeout cannot be negative", task.Type))
+	}
+	if task.RetryBackoff < 0 {
+		fe.Add(fmt.Sprintf("Task %v retryBackoff cannot be negative", task.Type))
+	}
+	for _, class := range task.RetryOn {
+		if class == "" {
+			fe.Add(fmt.Sprintf("Task %v retryOn cannot contain an empty entry", task.Type))
+		}
+	}
+	if len(task.RetryOn) > 0 && task.Retries == 0 {
+		fe.Add(fmt.Sprintf("Task %v retryOn requires retries", task.Type))
+	}
+	if task.Type == adapters.TaskTypeEthTx && (task.Timeout > 0 || task.Retries > 0) {
+		fe.Add(fmt.Sprintf("Task %v cannot have a timeout or retries, as an attempt that timed out or errored may still send its transaction", task.Type))
+	}
+	for _, name := range models.SecretReferences(task.Params) {
+		if _, err := store.FindSecret(name); err != nil {
+			fe.Add(fmt.Sprintf("Task %v references unknown secret %q", task.Type, name))
+		}
+	}
+	return fe.CoerceEmptyToNil()
 }
 
 // ValidateServiceAgreement checks the ServiceAgreement for any application logic errors.
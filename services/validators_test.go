@@ -67,6 +67,109 @@ func TestValidateJob(t *testing.T) {
 	}
 }
 
+func TestValidateJob_EthTxTimeoutAndRetries(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	tests := []struct {
+		name    string
+		timeout time.Duration
+		retries uint
+		wantErr bool
+	}{
+		{"neither", 0, 0, false},
+		{"timeout", time.Minute, 0, true},
+		{"retries", 0, 2, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			job, _ := cltest.NewJobWithWebInitiator()
+			task := cltest.NewTask("ethtx")
+			task.Timeout = models.Duration(test.timeout)
+			task.Retries = test.retries
+			job.Tasks = []models.TaskSpec{task}
+
+			err := services.ValidateJob(job, store)
+			assert.Equal(t, test.wantErr, err != nil)
+		})
+	}
+}
+
+func TestValidateJob_RunCompletionChain(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	parent, _ := cltest.NewJobWithWebInitiator()
+	require.NoError(t, store.SaveJob(&parent))
+
+	child := cltest.NewJob()
+	child.Initiators = []models.Initiator{{
+		Type:            models.InitiatorRunCompletion,
+		InitiatorParams: models.InitiatorParams{ParentJobID: parent.ID},
+	}}
+	assert.NoError(t, services.ValidateJob(child, store))
+	require.NoError(t, store.SaveJob(&child))
+
+	cyclic := parent
+	cyclic.Initiators = []models.Initiator{{
+		Type:            models.InitiatorRunCompletion,
+		InitiatorParams: models.InitiatorParams{ParentJobID: child.ID},
+	}}
+	assert.Error(t, services.ValidateJob(cyclic, store))
+
+	orphan := cltest.NewJob()
+	orphan.Initiators = []models.Initiator{{
+		Type:            models.InitiatorRunCompletion,
+		InitiatorParams: models.InitiatorParams{ParentJobID: "deadbeef"},
+	}}
+	assert.Error(t, services.ValidateJob(orphan, store))
+
+	badStatus := cltest.NewJob()
+	badStatus.Initiators = []models.Initiator{{
+		Type: models.InitiatorRunCompletion,
+		InitiatorParams: models.InitiatorParams{
+			ParentJobID:    parent.ID,
+			ParentStatuses: []models.RunStatus{models.RunStatusPendingBridge},
+		},
+	}}
+	assert.Error(t, services.ValidateJob(badStatus, store))
+}
+
+func TestValidateJob_TaskGraph(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	tests := []struct {
+		name      string
+		tasks     string
+		wantError bool
+	}{
+		{"linear", `[{"type":"noop"},{"type":"noop"}]`, false},
+		{"join", `[{"id":"a","type":"noop"},{"id":"b","type":"noop"},{"type":"noop","dependsOn":["a","b"]}]`, false},
+		{"duplicate id", `[{"id":"a","type":"noop"},{"id":"a","type":"noop"}]`, true},
+		{"unknown dependency", `[{"id":"a","type":"noop","dependsOn":["b"]}]`, true},
+		{"self dependency", `[{"id":"a","type":"noop","dependsOn":["a"]}]`, true},
+		{"cycle", `[{"id":"a","type":"noop","dependsOn":["b"]},{"id":"b","type":"noop","dependsOn":["a"]}]`, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var j models.JobSpec
+			input := fmt.Sprintf(`{"initiators":[{"type":"web"}],"tasks":%s}`, test.tasks)
+			require.NoError(t, json.Unmarshal([]byte(input), &j))
+
+			cltest.AssertError(t, test.wantError, services.ValidateJob(j, store))
+		})
+	}
+}
+
 func TestValidateAdapter(t *testing.T) {
 	t.Parallel()
 
@@ -136,6 +239,16 @@ func TestValidateInitiator(t *testing.T) {
 		{"runat w time after end at", fmt.Sprintf(`{"type":"runat","params": {"time":"%v"}}`, endAt.Add(time.Second).Unix()), true},
 		{"cron", `{"type":"cron","params": {"schedule":"* * * * * *"}}`, false},
 		{"cron w/o schedule", `{"type":"cron"}`, true},
+		{"cron w timezone", `{"type":"cron","params": {"schedule":"0 30 9 * * 1-5","timezone":"America/New_York"}}`, false},
+		{"cron w bad timezone", `{"type":"cron","params": {"schedule":"* * * * * *","timezone":"Mars/Olympus_Mons"}}`, true},
+		{"cron w jitter and policy", `{"type":"cron","params": {"schedule":"* * * * * *","jitter":"5s","overlapPolicy":"queue"}}`, false},
+		{"cron w misfire policy", `{"type":"cron","params": {"schedule":"* * * * * *","misfirePolicy":"fireAll","misfireLimit":5}}`, false},
+		{"cron w bad misfire policy", `{"type":"cron","params": {"schedule":"* * * * * *","misfirePolicy":"always"}}`, true},
+		{"cron w bad policy", `{"type":"cron","params": {"schedule":"* * * * * *","overlapPolicy":"sometimes"}}`, true},
+		{"runcompletion", `{"type":"runcompletion","params": {"parentJobId":"abc","parentStatuses":["errored"]}}`, false},
+		{"runcompletion w/o parent", `{"type":"runcompletion"}`, true},
+		{"pendingtx", `{"type":"pendingtx","params": {"toAddresses":["0x3cCad4715152693fE3BC4460591e3D3Fbd071b42"],"functionSelector":"0x70a08231"}}`, false},
+		{"pendingtx w/o addresses", `{"type":"pendingtx","params": {"functionSelector":"0x70a08231"}}`, true},
 		{"non-existent initiator", `{"type":"doesntExist"}`, true},
 	}
 
@@ -0,0 +1,231 @@
+package store
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/smartcontractkit/chainlink/logger"
+	"github.com/smartcontractkit/chainlink/store/assets"
+	"github.com/smartcontractkit/chainlink/store/models"
+	"github.com/tevino/abool"
+	"go.uber.org/multierr"
+)
+
+// balanceAlertTimeout is how long posting an alert to ETH_BALANCE_ALERT_URL
+// may take.
+const balanceAlertTimeout = 10 * time.Second
+
+// BalanceMonitor is a HeadTrackable which checks the ETH balances of the
+// active accounts every ETH_BALANCE_MONITOR_BLOCKS blocks. Accounts below
+// ETH_BALANCE_THRESHOLD, or without any ETH, are taken out of the rotation
+// for sending transactions until they are topped up, and
+// ETH_BALANCE_ALERT_URL is alerted when they drop below it.
+type BalanceMonitor struct {
+	txm         *EthTxManager
+	client      *http.Client
+	running     *abool.AtomicBool
+	mutex       sync.RWMutex
+	lastChecked uint64
+	balances    map[common.Address]models.EthBalance
+}
+
+func newBalanceMonitor(txm *EthTxManager) *BalanceMonitor {
+	return &BalanceMonitor{
+		txm:      txm,
+		client:   &http.Client{Timeout: balanceAlertTimeout},
+		running:  abool.New(),
+		balances: map[common.Address]models.EthBalance{},
+	}
+}
+
+// Connect checks the balances in the background.
+func (bm *BalanceMonitor) Connect(bn *models.IndexableBlockNumber) error {
+	var blockNumber uint64
+	if bn != nil {
+		blockNumber = bn.ToInt().Uint64()
+	}
+	bm.checkInBackground(blockNumber)
+	return nil
+}
+
+// Disconnect does nothing; exists to comply with interface.
+func (bm *BalanceMonitor) Disconnect() {}
+
+// OnNewHead checks the balances in the background if
+// ETH_BALANCE_MONITOR_BLOCKS have passed since they last were.
+func (bm *BalanceMonitor) OnNewHead(head *models.BlockHeader) {
+	blockNumber := head.Number.ToInt().Uint64()
+	bm.mutex.RLock()
+	due := blockNumber >= bm.lastChecked+bm.txm.config.EthBalanceMonitorBlocks()
+	bm.mutex.RUnlock()
+	if due {
+		bm.checkInBackground(blockNumber)
+	}
+}
+
+// OnReorg does nothing, since the balances are checked again shortly after.
+func (bm *BalanceMonitor) OnReorg(*models.Reorg) {}
+
+func (bm *BalanceMonitor) checkInBackground(blockNumber uint64) {
+	if bm.txm.config.EthBalanceMonitorBlocks() == 0 || !bm.running.SetToIf(false, true) {
+		return
+	}
+	go func() {
+		defer bm.running.UnSet()
+		if err := bm.CheckBalances(blockNumber); err != nil {
+			logger.Warnw("Unable to check account balances", "err", err)
+		}
+	}()
+}
+
+// Balances returns the ETH balances of the accounts as last checked, sorted
+// by address.
+func (bm *BalanceMonitor) Balances() []models.EthBalance {
+	bm.mutex.RLock()
+	defer bm.mutex.RUnlock()
+
+	balances := []models.EthBalance{}
+	for _, eb := range bm.balances {
+		balances = append(balances, eb)
+	}
+	sort.Slice(balances, func(i, j int) bool {
+		return balances[i].Address.Hex() < balances[j].Address.Hex()
+	})
+	return balances
+}
+
+// CheckHealth returns an error if the ETH balance of any account with
+// sending enabled was low when last checked.
+func (bm *BalanceMonitor) CheckHealth() error {
+	if low := bm.txm.lowBalanceAccounts(); low > 0 {
+		threshold := (*assets.Eth)(bm.txm.config.EthBalanceThreshold())
+		return fmt.Errorf("%v account(s) with sending enabled have no ETH or a balance below %v ETH", low, threshold)
+	}
+	return nil
+}
+
+// CheckBalances checks the ETH balance of each active account as of the
+// given block, taking those below ETH_BALANCE_THRESHOLD out of the rotation
+// for sending transactions and returning those topped up to it.
+func (bm *BalanceMonitor) CheckBalances(blockNumber uint64) error {
+	bm.mutex.Lock()
+	bm.lastChecked = blockNumber
+	bm.mutex.Unlock()
+
+	threshold := (*assets.Eth)(bm.txm.config.EthBalanceThreshold())
+	active := bm.txm.activeAccounts()
+	bm.retain(active)
+
+	var merr error
+	for _, ma := range active {
+		balance, err := bm.txm.GetEthBalance(ma.Address)
+		if err != nil {
+			merr = multierr.Append(merr, fmt.Errorf("checking balance of %v: %v", ma.Address.Hex(), err))
+			continue
+		}
+
+		eb := models.EthBalance{
+			Address:     ma.Address,
+			Balance:     balance,
+			Low:         balance.IsZero() || balance.Cmp(threshold) < 0,
+			BlockNumber: blockNumber,
+			CheckedAt:   time.Now(),
+		}
+		wasLow := bm.record(eb)
+		ma.lowBalance.SetTo(eb.Low)
+
+		if eb.Low {
+			logger.Warnw(
+				fmt.Sprintf("ETH balance of %v is below %v ETH, so it will not send transactions until topped up", ma.Address.Hex(), threshold),
+				"address", ma.Address.Hex(),
+				"balance", balance.String(),
+			)
+			if !wasLow {
+				merr = multierr.Append(merr, bm.alert(eb, threshold))
+			}
+		} else if wasLow {
+			logger.Infow(
+				fmt.Sprintf("ETH balance of %v has been topped up to %v ETH", ma.Address.Hex(), balance),
+				"address", ma.Address.Hex(),
+				"balance", balance.String(),
+			)
+		}
+	}
+	return merr
+}
+
+// record keeps the balance to report, returning whether the account's
+// balance was low when previously checked.
+func (bm *BalanceMonitor) record(eb models.EthBalance) bool {
+	bm.mutex.Lock()
+	defer bm.mutex.Unlock()
+
+	previous, ok := bm.balances[eb.Address]
+	bm.balances[eb.Address] = eb
+	return ok && previous.Low
+}
+
+// retain drops the balances of accounts which are no longer active, such as
+// those removed from the keystore.
+func (bm *BalanceMonitor) retain(active []*ManagedAccount) {
+	bm.mutex.Lock()
+	defer bm.mutex.Unlock()
+
+	balances := map[common.Address]models.EthBalance{}
+	for _, ma := range active {
+		if eb, ok := bm.balances[ma.Address]; ok {
+			balances[ma.Address] = eb
+		}
+	}
+	bm.balances = balances
+}
+
+// forget drops the balance of an account removed from the keystore.
+func (bm *BalanceMonitor) forget(address common.Address) {
+	bm.mutex.Lock()
+	defer bm.mutex.Unlock()
+
+	delete(bm.balances, address)
+}
+
+// isLow returns whether the account's balance was low when last checked.
+func (bm *BalanceMonitor) isLow(address common.Address) bool {
+	bm.mutex.RLock()
+	defer bm.mutex.RUnlock()
+
+	return bm.balances[address].Low
+}
+
+// lowBalanceAlert is posted to ETH_BALANCE_ALERT_URL when an account's ETH
+// balance drops below ETH_BALANCE_THRESHOLD.
+type lowBalanceAlert struct {
+	models.EthBalance
+	Threshold *assets.Eth `json:"threshold"`
+}
+
+func (bm *BalanceMonitor) alert(eb models.EthBalance, threshold *assets.Eth) error {
+	alertURL := bm.txm.config.EthBalanceAlertURL().String()
+	if alertURL == "" {
+		return nil
+	}
+
+	body, err := json.Marshal(lowBalanceAlert{EthBalance: eb, Threshold: threshold})
+	if err != nil {
+		return err
+	}
+	resp, err := bm.client.Post(alertURL, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("sending low balance alert: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 400 {
+		return fmt.Errorf("low balance alert returned %v", resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,118 @@
+package store_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/internal/cltest"
+	strpkg "github.com/smartcontractkit/chainlink/store"
+	"github.com/smartcontractkit/chainlink/store/assets"
+	"github.com/smartcontractkit/chainlink/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBalanceMonitor_CheckBalances(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplicationWithKeyStore()
+	defer cleanup()
+	store := app.Store
+
+	alerts := make(chan map[string]interface{}, 10)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var alert map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&alert))
+		alerts <- alert
+	}))
+	defer server.Close()
+	store.Config.Set("ETH_BALANCE_THRESHOLD", "1000")
+	store.Config.Set("ETH_BALANCE_ALERT_URL", server.URL)
+
+	ethMock := app.MockEthClient()
+	ethMock.Context("app.StartAndConnect()", func(ethMock *cltest.EthMock) {
+		ethMock.Register("eth_getTransactionCount", utils.Uint64ToHex(0))
+	})
+	require.NoError(t, app.StartAndConnect())
+
+	from := cltest.GetAccountAddress(store)
+	monitor := store.BalanceMonitor
+	require.NotNil(t, store.TxManager.NextActiveAccount())
+	assert.NoError(t, monitor.CheckHealth())
+
+	ethMock.Register("eth_getBalance", "0x3e7")
+	require.NoError(t, monitor.CheckBalances(10))
+
+	assert.Nil(t, store.TxManager.NextActiveAccount())
+	_, err := store.TxManager.CreateTx(cltest.NewAddress(), []byte{})
+	assert.Equal(t, strpkg.ErrLowBalance, err)
+	assert.Error(t, monitor.CheckHealth())
+	balances := monitor.Balances()
+	require.Len(t, balances, 1)
+	assert.Equal(t, from, balances[0].Address)
+	assert.Equal(t, assets.NewEth(999), balances[0].Balance)
+	assert.True(t, balances[0].Low)
+	assert.Equal(t, uint64(10), balances[0].BlockNumber)
+
+	require.Len(t, alerts, 1)
+	alert := <-alerts
+	assert.Equal(t, from.Hex(), alert["address"])
+	assert.Equal(t, "999", alert["balance"])
+	assert.Equal(t, "1000", alert["threshold"])
+
+	ethMock.Register("eth_getBalance", "0x3e7")
+	require.NoError(t, monitor.CheckBalances(20))
+	assert.Len(t, alerts, 0, "should only alert when the balance drops below the threshold")
+
+	ethMock.Register("eth_getBalance", "0x3e8")
+	require.NoError(t, monitor.CheckBalances(30))
+
+	assert.Equal(t, from, store.TxManager.NextActiveAccount().Address)
+	assert.NoError(t, monitor.CheckHealth())
+	assert.False(t, monitor.Balances()[0].Low)
+	ethMock.EventuallyAllCalled(t)
+}
+
+func TestBalanceMonitor_CheckBalances_ZeroThreshold(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplicationWithKeyStore()
+	defer cleanup()
+	store := app.Store
+
+	ethMock := app.MockEthClient()
+	ethMock.Context("app.StartAndConnect()", func(ethMock *cltest.EthMock) {
+		ethMock.Register("eth_getTransactionCount", utils.Uint64ToHex(0))
+	})
+	require.NoError(t, app.StartAndConnect())
+
+	from := cltest.GetAccountAddress(store)
+	account, err := store.KeyStore.NewAccount(cltest.Password)
+	require.NoError(t, err)
+	ethMock.Register("eth_getTransactionCount", utils.Uint64ToHex(0))
+	store.TxManager.AddAccount(account)
+
+	monitor := store.BalanceMonitor
+	ethMock.Register("eth_getBalance", "0x0")
+	ethMock.Register("eth_getBalance", "0x0")
+	require.NoError(t, monitor.CheckBalances(10))
+
+	balances := monitor.Balances()
+	require.Len(t, balances, 2)
+	assert.True(t, balances[0].Low, "accounts without any ETH are low even when the threshold is 0")
+	assert.True(t, balances[1].Low)
+	assert.Nil(t, store.TxManager.NextActiveAccount())
+
+	require.NoError(t, store.TxManager.SetSendDisabled(from, true))
+	err = monitor.CheckHealth()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "1 account(s)", "accounts with sending disabled are not counted")
+
+	require.NoError(t, store.TxManager.RemoveAccount(account.Address))
+	require.Len(t, monitor.Balances(), 1, "removed accounts are no longer reported")
+	assert.Equal(t, from, monitor.Balances()[0].Address)
+	assert.NoError(t, monitor.CheckHealth())
+	ethMock.EventuallyAllCalled(t)
+}
@@ -46,19 +46,41 @@ type ConfigSchema struct {
 	Dev                      bool           `env:"CHAINLINK_DEV" default:"false"`
 	MaximumServiceDuration   time.Duration  `env:"MAXIMUM_SERVICE_DURATION" default:"8760h" `
 	MinimumServiceDuration   time.Duration  `env:"MINIMUM_SERVICE_DURATION" default:"0s" `
+	EthBalanceAlertURL       url.URL        `env:"ETH_BALANCE_ALERT_URL"`
+	EthBalanceMonitorBlocks  uint64         `env:"ETH_BALANCE_MONITOR_BLOCKS" default:"10"`
+	EthBalanceThreshold      big.Int        `env:"ETH_BALANCE_THRESHOLD" default:"0"`
 	EthGasBumpThreshold      uint64         `env:"ETH_GAS_BUMP_THRESHOLD" default:"12" `
 	EthGasBumpWei            big.Int        `env:"ETH_GAS_BUMP_WEI" default:"5000000000"`
 	EthGasPriceDefault       big.Int        `env:"ETH_GAS_PRICE_DEFAULT" default:"20000000000"`
+	EthGasPriceEstimator     string         `env:"ETH_GAS_PRICE_ESTIMATOR" default:"fixed"`
+	EthGasPriceBlockHistory  uint64         `env:"ETH_GAS_PRICE_BLOCK_HISTORY" default:"20"`
+	EthGasPricePercentile    uint64         `env:"ETH_GAS_PRICE_PERCENTILE" default:"60"`
+	EthGasPriceMultiplier    float64        `env:"ETH_GAS_PRICE_MULTIPLIER" default:"1.0"`
+	EthGasPriceMin           big.Int        `env:"ETH_GAS_PRICE_MIN" default:"1000000000"`
+	EthGasPriceMax           big.Int        `env:"ETH_GAS_PRICE_MAX" default:"500000000000"`
+	EthGasLimitEstimation    bool           `env:"ETH_GAS_LIMIT_ESTIMATION" default:"false"`
+	EthGasLimitMultiplier    float64        `env:"ETH_GAS_LIMIT_MULTIPLIER" default:"1.25"`
+	EthTxPreflight           bool           `env:"ETH_TX_PREFLIGHT" default:"false"`
+	EthTxReconcilePeriod     time.Duration  `env:"ETH_TX_RECONCILE_PERIOD" default:"5m"`
 	EthereumURL              string         `env:"ETH_URL" default:"ws://localhost:8546"`
+	EthereumSecondaryURLs    string         `env:"ETH_SECONDARY_URLS"`
+	EthereumSendOnlyURLs     string         `env:"ETH_SENDONLY_URLS"`
+	EthNodeHealthCheckPeriod time.Duration  `env:"ETH_NODE_HEALTH_CHECK_PERIOD" default:"15s"`
+	EthNodeMaxHeadAge        time.Duration  `env:"ETH_NODE_MAX_HEAD_AGE" default:"2m"`
+	EthNodeMaxLatency        time.Duration  `env:"ETH_NODE_MAX_LATENCY" default:"5s"`
+	EthPollingInterval       time.Duration  `env:"ETH_POLLING_INTERVAL" default:"5s"`
+	HeadHistoryDepth         uint64         `env:"HEAD_HISTORY_DEPTH" default:"100"`
 	JSONConsole              bool           `env:"JSON_CONSOLE" default:"false"`
 	LinkContractAddress      string         `env:"LINK_CONTRACT_ADDRESS" default:"0x514910771AF9Ca656af840dff83E8264EcF986CA"`
 	LogLevel                 LogLevel       `env:"LOG_LEVEL" default:"info"`
 	LogToDisk                bool           `env:"LOG_TO_DISK" default:"true"`
+	MaxConcurrentRuns        uint64         `env:"MAX_CONCURRENT_RUNS" default:"100"`
 	MinIncomingConfirmations uint64         `env:"MIN_INCOMING_CONFIRMATIONS" default:"0"`
 	MinOutgoingConfirmations uint64         `env:"MIN_OUTGOING_CONFIRMATIONS" default:"12"`
 	MinimumContractPayment   assets.Link    `env:"MINIMUM_CONTRACT_PAYMENT" default:"1000000000000000000"`
 	MinimumRequestExpiration uint64         `env:"MINIMUM_REQUEST_EXPIRATION" default:"300" `
 	OracleContractAddress    common.Address `env:"ORACLE_CONTRACT_ADDRESS"`
+	OrphanedRunTimeout       time.Duration  `env:"ORPHANED_RUN_TIMEOUT" default:"1h"`
 	Port                     uint16         `env:"CHAINLINK_PORT" default:"6688"`
 	ReaperExpiration         time.Duration  `env:"REAPER_EXPIRATION" default:"240h"`
 	RootDir                  string         `env:"ROOT" default:"~/.chainlink"`
@@ -168,6 +190,26 @@ func (c Config) EthGasBumpThreshold() uint64 {
 	return uint64(c.viper.GetInt64(c.envVarName("EthGasBumpThreshold")))
 }
 
+// EthBalanceAlertURL is the URL a JSON alert is posted to when an account's
+// ETH balance drops below EthBalanceThreshold. No alerts are sent if it is
+// empty.
+func (c Config) EthBalanceAlertURL() *url.URL {
+	return c.getWithFallback("EthBalanceAlertURL", parseURL).(*url.URL)
+}
+
+// EthBalanceMonitorBlocks is how many blocks apart the ETH balances of the
+// accounts are checked. Balances are not monitored when it is 0.
+func (c Config) EthBalanceMonitorBlocks() uint64 {
+	return uint64(c.viper.GetInt64(c.envVarName("EthBalanceMonitorBlocks")))
+}
+
+// EthBalanceThreshold is the ETH balance in wei below which an account is
+// taken out of the rotation for sending transactions until it is topped up.
+// When it is 0, only accounts without any ETH are taken out of the rotation.
+func (c Config) EthBalanceThreshold() *big.Int {
+	return c.getWithFallback("EthBalanceThreshold", parseBigInt).(*big.Int)
+}
+
 // EthGasBumpWei represents the intervals in which ETH should be increased when
 // doing gas bumping.
 func (c Config) EthGasBumpWei() *big.Int {
@@ -179,11 +221,116 @@ func (c Config) EthGasPriceDefault() *big.Int {
 	return c.getWithFallback("EthGasPriceDefault", parseBigInt).(*big.Int)
 }
 
+// EthGasPriceEstimator is how the gas price of outgoing transactions is
+// chosen: "fixed" always uses EthGasPriceDefault, "percentile" samples the
+// gas prices paid in recent blocks and "rpc" uses the node's eth_gasPrice.
+func (c Config) EthGasPriceEstimator() string {
+	return c.viper.GetString(c.envVarName("EthGasPriceEstimator"))
+}
+
+// EthGasPriceBlockHistory is the number of recent blocks the percentile gas
+// price estimator samples.
+func (c Config) EthGasPriceBlockHistory() uint64 {
+	return uint64(c.viper.GetInt64(c.envVarName("EthGasPriceBlockHistory")))
+}
+
+// EthGasPricePercentile is the percentile of the gas prices paid in recent
+// blocks that the percentile gas price estimator uses.
+func (c Config) EthGasPricePercentile() uint64 {
+	return uint64(c.viper.GetInt64(c.envVarName("EthGasPricePercentile")))
+}
+
+// EthGasPriceMultiplier is applied to estimated gas prices.
+func (c Config) EthGasPriceMultiplier() float64 {
+	return c.viper.GetFloat64(c.envVarName("EthGasPriceMultiplier"))
+}
+
+// EthGasPriceMin is the lowest gas price that will be estimated.
+func (c Config) EthGasPriceMin() *big.Int {
+	return c.getWithFallback("EthGasPriceMin", parseBigInt).(*big.Int)
+}
+
+// EthGasPriceMax is the highest gas price that will be estimated or bumped
+// to.
+func (c Config) EthGasPriceMax() *big.Int {
+	return c.getWithFallback("EthGasPriceMax", parseBigInt).(*big.Int)
+}
+
+// EthGasLimitEstimation enables estimating the gas limit of outgoing
+// transactions with eth_estimateGas, instead of using the default.
+func (c Config) EthGasLimitEstimation() bool {
+	return c.viper.GetBool(c.envVarName("EthGasLimitEstimation"))
+}
+
+// EthGasLimitMultiplier is applied to estimated gas limits, leaving room for
+// the state to change before the transaction is mined.
+func (c Config) EthGasLimitMultiplier() float64 {
+	return c.viper.GetFloat64(c.envVarName("EthGasLimitMultiplier"))
+}
+
+// EthTxPreflight enables executing outgoing transactions with eth_call
+// before sending them, so that those which would revert are never sent.
+func (c Config) EthTxPreflight() bool {
+	return c.viper.GetBool(c.envVarName("EthTxPreflight"))
+}
+
+// EthTxReconcilePeriod is how often unconfirmed transactions are reconciled
+// with the chain, after first doing so on connecting. Reconciliation is
+// disabled when it is 0.
+func (c Config) EthTxReconcilePeriod() time.Duration {
+	return c.viper.GetDuration(c.envVarName("EthTxReconcilePeriod"))
+}
+
 // EthereumURL represents the URL of the Ethereum node to connect Chainlink to.
 func (c Config) EthereumURL() string {
 	return c.viper.GetString(c.envVarName("EthereumURL"))
 }
 
+// EthereumSecondaryURLs is a comma separated list of the URLs of Ethereum
+// nodes to fail over to when the node at EthereumURL is unhealthy, in order
+// of priority.
+func (c Config) EthereumSecondaryURLs() string {
+	return c.viper.GetString(c.envVarName("EthereumSecondaryURLs"))
+}
+
+// EthereumSendOnlyURLs is a comma separated list of the URLs of Ethereum
+// nodes that signed transactions are also broadcast to, but which are never
+// read from.
+func (c Config) EthereumSendOnlyURLs() string {
+	return c.viper.GetString(c.envVarName("EthereumSendOnlyURLs"))
+}
+
+// EthNodeHealthCheckPeriod is how often the Ethereum nodes are health
+// checked when more than one is configured.
+func (c Config) EthNodeHealthCheckPeriod() time.Duration {
+	return c.viper.GetDuration(c.envVarName("EthNodeHealthCheckPeriod"))
+}
+
+// EthNodeMaxHeadAge is the age of its latest block beyond which an Ethereum
+// node is considered stalled.
+func (c Config) EthNodeMaxHeadAge() time.Duration {
+	return c.viper.GetDuration(c.envVarName("EthNodeMaxHeadAge"))
+}
+
+// EthNodeMaxLatency is how long an Ethereum node may take to answer a
+// health check before it is considered unhealthy.
+func (c Config) EthNodeMaxLatency() time.Duration {
+	return c.viper.GetDuration(c.envVarName("EthNodeMaxLatency"))
+}
+
+// EthPollingInterval is how often Ethereum nodes dialed over http are polled
+// for new heads and logs.
+func (c Config) EthPollingInterval() time.Duration {
+	return c.viper.GetDuration(c.envVarName("EthPollingInterval"))
+}
+
+// HeadHistoryDepth is the number of recent block headers kept to detect
+// chain reorganisations. Reorganisations deeper than this are treated as if
+// they forked just below the oldest header kept.
+func (c Config) HeadHistoryDepth() uint64 {
+	return uint64(c.viper.GetInt64(c.envVarName("HeadHistoryDepth")))
+}
+
 // JSONConsole enables the JSON console.
 func (c Config) JSONConsole() bool {
 	return c.viper.GetBool(c.envVarName("JSONConsole"))
@@ -202,6 +349,13 @@ func (c Config) OracleContractAddress() *common.Address {
 	return c.getWithFallback("OracleContractAddress", parseAddress).(*common.Address)
 }
 
+// OrphanedRunTimeout is how long a run whose triggering log was orphaned by a
+// chain reorganisation waits for the log's transaction to be included in the
+// new chain before it is failed.
+func (c Config) OrphanedRunTimeout() time.Duration {
+	return c.viper.GetDuration(c.envVarName("OrphanedRunTimeout"))
+}
+
 // LogLevel represents the maximum level of log messages to output.
 func (c Config) LogLevel() LogLevel {
 	return c.getWithFallback("LogLevel", parseLogLevel).(LogLevel)
@@ -212,6 +366,13 @@ func (c Config) LogToDisk() bool {
 	return c.viper.GetBool(c.envVarName("LogToDisk"))
 }
 
+// MaxConcurrentRuns is the number of runs the job runner executes at once.
+// Further runs wait in the run queue until a worker is free. Zero leaves the
+// number of runs unbounded.
+func (c Config) MaxConcurrentRuns() uint64 {
+	return uint64(c.viper.GetInt64(c.envVarName("MaxConcurrentRuns")))
+}
+
 // MinIncomingConfirmations represents the minimum number of block
 // confirmations that need to be recorded since a job run started before a task
 // can proceed.
@@ -34,6 +34,17 @@ func (eth *EthClient) GetNonce(address common.Address) (uint64, error) {
 	return utils.HexToUint64(result)
 }
 
+// GetPendingNonce returns the nonce of the account's next transaction,
+// counting the transactions in the node's pool.
+func (eth *EthClient) GetPendingNonce(address common.Address) (uint64, error) {
+	result := ""
+	err := eth.Call(&result, "eth_getTransactionCount", address.Hex(), "pending")
+	if err != nil {
+		return 0, err
+	}
+	return utils.HexToUint64(result)
+}
+
 // GetWeiBalance returns the balance of the given address in Wei.
 func (eth *EthClient) GetWeiBalance(address common.Address) (*big.Int, error) {
 	result := ""
@@ -96,6 +107,62 @@ func (eth *EthClient) GetERC20Balance(address common.Address, contractAddress co
 	return numLinkBigInt, nil
 }
 
+// CallContract executes a message call against the latest block without
+// creating a transaction, returning the call's return data. The call is made
+// without a sender when from is the zero address.
+func (eth *EthClient) CallContract(from, to common.Address, data []byte) (hexutil.Bytes, error) {
+	args := struct {
+		From *common.Address `json:"from,omitempty"`
+		To   common.Address  `json:"to"`
+		Data hexutil.Bytes   `json:"data"`
+	}{To: to, Data: data}
+	if from != (common.Address{}) {
+		args.From = &from
+	}
+
+	var result hexutil.Bytes
+	err := eth.Call(&result, "eth_call", args, "latest")
+	return result, err
+}
+
+// ReplayTx executes the transaction with eth_call against the state at the
+// given block, returning the call's return data.
+func (eth *EthClient) ReplayTx(tx *models.Tx, blockNumber *big.Int) (hexutil.Bytes, error) {
+	args := struct {
+		From  common.Address `json:"from"`
+		To    common.Address `json:"to"`
+		Gas   hexutil.Uint64 `json:"gas"`
+		Value *hexutil.Big   `json:"value,omitempty"`
+		Data  hexutil.Bytes  `json:"data"`
+	}{
+		From: tx.From,
+		To:   tx.To,
+		Gas:  hexutil.Uint64(tx.GasLimit),
+		Data: tx.Data,
+	}
+	if tx.Value != nil {
+		args.Value = (*hexutil.Big)(tx.Value)
+	}
+
+	var result hexutil.Bytes
+	err := eth.Call(&result, "eth_call", args, hexutil.EncodeBig(blockNumber))
+	return result, err
+}
+
+// EstimateGas returns the gas a transaction would use if sent from the given
+// address against the latest block.
+func (eth *EthClient) EstimateGas(from, to common.Address, data []byte) (uint64, error) {
+	args := struct {
+		From common.Address `json:"from"`
+		To   common.Address `json:"to"`
+		Data hexutil.Bytes  `json:"data"`
+	}{From: from, To: to, Data: data}
+
+	var result hexutil.Uint64
+	err := eth.Call(&result, "eth_estimateGas", args)
+	return uint64(result), err
+}
+
 // SendRawTx sends a signed transaction to the transaction pool.
 func (eth *EthClient) SendRawTx(hex string) (common.Hash, error) {
 	result := common.Hash{}
@@ -126,6 +193,23 @@ func (eth *EthClient) GetBlockByNumber(hex string) (models.BlockHeader, error) {
 	return header, err
 }
 
+// GetBlockWithTransactions returns the block for the passed hex, or
+// "latest", "earliest", "pending", along with its full transactions.
+func (eth *EthClient) GetBlockWithTransactions(hex string) (models.Block, error) {
+	var block models.Block
+	err := eth.Call(&block, "eth_getBlockByNumber", hex, true)
+	return block, err
+}
+
+// GetGasPrice returns the gas price suggested by the node.
+func (eth *EthClient) GetGasPrice() (*big.Int, error) {
+	var result hexutil.Big
+	if err := eth.Call(&result, "eth_gasPrice"); err != nil {
+		return nil, err
+	}
+	return result.ToInt(), nil
+}
+
 // GetLogs returns all logs that respect the passed filter query.
 func (eth *EthClient) GetLogs(q ethereum.FilterQuery) ([]models.Log, error) {
 	var results []models.Log
@@ -154,11 +238,39 @@ func (eth *EthClient) SubscribeToNewHeads(
 	return sub, err
 }
 
-// TxReceipt holds the block number and the transaction hash of a signed
-// transaction that has been written to the blockchain.
+// SubscribeToPendingTransactions registers a subscription for push
+// notifications of transactions entering the node's transaction pool. Full
+// transaction objects are requested, falling back to bare transaction
+// hashes on nodes that do not support them. Nodes dialed over http return an
+// UnsupportedSubscriptionError, since pending transactions cannot be polled
+// for.
+func (eth *EthClient) SubscribeToPendingTransactions(
+	channel chan<- models.PendingTransaction,
+) (models.EthSubscription, error) {
+	ctx := context.Background()
+	sub, err := eth.EthSubscribe(ctx, channel, "newPendingTransactions", true)
+	if _, unsupported := err.(UnsupportedSubscriptionError); err == nil || unsupported {
+		return sub, err
+	}
+	return eth.EthSubscribe(ctx, channel, "newPendingTransactions")
+}
+
+// GetTransactionByHash returns the pending or mined transaction with the
+// given hash, which only has its Hash set if the node does not know of it.
+func (eth *EthClient) GetTransactionByHash(hash common.Hash) (models.PendingTransaction, error) {
+	tx := models.PendingTransaction{Hash: hash}
+	err := eth.Call(&tx, "eth_getTransactionByHash", hash.String())
+	return tx, err
+}
+
+// TxReceipt holds the block number, transaction hash, status, gas used and
+// logs of a signed transaction that has been written to the blockchain.
 type TxReceipt struct {
-	BlockNumber *models.Int `json:"blockNumber"`
-	Hash        common.Hash `json:"transactionHash"`
+	BlockNumber *models.Int     `json:"blockNumber"`
+	Hash        common.Hash     `json:"transactionHash"`
+	Status      *hexutil.Uint64 `json:"status,omitempty"`
+	GasUsed     *hexutil.Uint64 `json:"gasUsed,omitempty"`
+	Logs        []models.Log    `json:"logs,omitempty"`
 }
 
 var emptyHash = common.Hash{}
@@ -167,3 +279,9 @@ var emptyHash = common.Hash{}
 func (txr *TxReceipt) Unconfirmed() bool {
 	return txr.Hash == emptyHash || txr.BlockNumber == nil
 }
+
+// Reverted returns true if the transaction was mined but reverted. Receipts
+// from before the Byzantium fork have no status, and are never reverted.
+func (txr *TxReceipt) Reverted() bool {
+	return !txr.Unconfirmed() && txr.Status != nil && *txr.Status == 0
+}
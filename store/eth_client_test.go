@@ -28,6 +28,9 @@ func TestEthClient_GetTxReceipt(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, hash, receipt.Hash)
 	assert.Equal(t, cltest.Int(uint64(11)), receipt.BlockNumber)
+	require.NotNil(t, receipt.GasUsed)
+	assert.Equal(t, uint64(0x4dc), uint64(*receipt.GasUsed))
+	assert.False(t, receipt.Reverted())
 }
 
 func TestTxReceipt_UnmarshalJSON(t *testing.T) {
@@ -35,6 +38,15 @@ func TestTxReceipt_UnmarshalJSON(t *testing.T) {
 	var receipt strpkg.TxReceipt
 	err := json.Unmarshal([]byte(jsonStr), &receipt)
 	require.NoError(t, err)
+	assert.False(t, receipt.Reverted())
+}
+
+func TestTxReceipt_Reverted(t *testing.T) {
+	jsonStr := `{"blockNumber":"0xb","transactionHash":"0x6941ab7592a5f8ec5158b0de17129939170db06675b10c8b3e4e9f6ca2d0882b","status":"0x0","gasUsed":"0x5208","logs":[]}`
+	var receipt strpkg.TxReceipt
+	require.NoError(t, json.Unmarshal([]byte(jsonStr), &receipt))
+	assert.True(t, receipt.Reverted())
+	assert.Equal(t, uint64(21000), uint64(*receipt.GasUsed))
 }
 
 func TestEthClient_GetNonce(t *testing.T) {
@@ -0,0 +1,430 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/smartcontractkit/chainlink/logger"
+	"github.com/smartcontractkit/chainlink/store/models"
+)
+
+// ErrEthNodeSwitched is sent on the subscriptions made on an Ethereum node
+// when another node becomes active, so that they are re-established there.
+var ErrEthNodeSwitched = errors.New("switched to another Ethereum node")
+
+// EthNodes is a CallerSubscriber over the Ethereum nodes in the config.
+// Calls and subscriptions go to the active node, which is the first healthy
+// node of EthereumURL followed by EthereumSecondaryURLs. Raw transactions
+// are broadcast to every node, including those in EthereumSendOnlyURLs.
+type EthNodes struct {
+	config        Config
+	primaries     []*ethNode
+	sendOnly      []*ethNode
+	active        *ethNode
+	subscriptions map[*ethNodeSubscription]struct{}
+	mutex         sync.RWMutex
+	done          chan struct{}
+	wg            sync.WaitGroup
+}
+
+// NewEthNodes dials each of the Ethereum nodes in the config.
+func NewEthNodes(config Config, dialer Dialer) (*EthNodes, error) {
+	nodes := &EthNodes{
+		config:        config,
+		subscriptions: map[*ethNodeSubscription]struct{}{},
+	}
+
+	primaryURLs := append([]string{config.EthereumURL()}, splitURLs(config.EthereumSecondaryURLs())...)
+	for _, url := range primaryURLs {
+		node, err := dialEthNode(dialer, url, len(nodes.primaries), false)
+		if err != nil {
+			return nil, err
+		}
+		nodes.primaries = append(nodes.primaries, node)
+	}
+	for _, url := range splitURLs(config.EthereumSendOnlyURLs()) {
+		node, err := dialEthNode(dialer, url, len(nodes.primaries)+len(nodes.sendOnly), true)
+		if err != nil {
+			return nil, err
+		}
+		nodes.sendOnly = append(nodes.sendOnly, node)
+	}
+
+	nodes.active = nodes.primaries[0]
+	return nodes, nil
+}
+
+func splitURLs(list string) []string {
+	var urls []string
+	for _, url := range strings.Split(list, ",") {
+		if url = strings.TrimSpace(url); url != "" {
+			urls = append(urls, url)
+		}
+	}
+	return urls
+}
+
+// Start health checks the nodes every EthNodeHealthCheckPeriod, when there
+// is more than one node to fail over or broadcast to.
+func (nodes *EthNodes) Start() {
+	if len(nodes.all()) < 2 || nodes.done != nil {
+		return
+	}
+
+	nodes.done = make(chan struct{})
+	nodes.wg.Add(1)
+	go nodes.checkHealthPeriodically()
+}
+
+// Stop stops health checking the nodes.
+func (nodes *EthNodes) Stop() {
+	if nodes.done == nil {
+		return
+	}
+
+	close(nodes.done)
+	nodes.wg.Wait()
+	nodes.done = nil
+}
+
+func (nodes *EthNodes) checkHealthPeriodically() {
+	defer nodes.wg.Done()
+	for {
+		nodes.CheckHealth()
+		select {
+		case <-nodes.done:
+			return
+		case <-time.After(nodes.config.EthNodeHealthCheckPeriod()):
+		}
+	}
+}
+
+// CheckHealth checks that each node answers within EthNodeMaxLatency and,
+// unless it is send only, that its latest block is no older than
+// EthNodeMaxHeadAge. The highest priority healthy node is then made active.
+func (nodes *EthNodes) CheckHealth() {
+	var wg sync.WaitGroup
+	for _, node := range nodes.all() {
+		wg.Add(1)
+		go func(node *ethNode) {
+			defer wg.Done()
+			node.checkHealth(nodes.config)
+		}(node)
+	}
+	wg.Wait()
+	nodes.failover()
+}
+
+// Statuses returns the status of each node in order of priority.
+func (nodes *EthNodes) Statuses() []models.EthNodeStatus {
+	active := nodes.activeNode()
+	var statuses []models.EthNodeStatus
+	for _, node := range nodes.all() {
+		status := node.Status()
+		status.Active = node == active
+		statuses = append(statuses, status)
+	}
+	return statuses
+}
+
+// ActiveURL returns the URL of the node calls and subscriptions go to.
+func (nodes *EthNodes) ActiveURL() string {
+	return nodes.activeNode().url
+}
+
+// Call performs the JSON-RPC call on the active node, failing over and
+// retrying on the next healthy node when the active node cannot be reached.
+// Raw transactions are broadcast to every node.
+func (nodes *EthNodes) Call(result interface{}, method string, args ...interface{}) error {
+	if method == "eth_sendRawTransaction" {
+		return nodes.broadcast(result, method, args...)
+	}
+
+	active := nodes.activeNode()
+	err := active.client.Call(result, method, args...)
+	if err == nil || !nodes.unreachable(active, err) {
+		return err
+	}
+	if next := nodes.activeNode(); next != active {
+		return next.client.Call(result, method, args...)
+	}
+	return err
+}
+
+// broadcast sends the call to every node at once, so that a transaction
+// reaches the network even when the active node cannot be reached. The
+// result, or the error the active node responded with, is taken from the
+// active node. Only when the active node could not be reached is the result
+// taken from the first other node that accepted the call.
+func (nodes *EthNodes) broadcast(result interface{}, method string, args ...interface{}) error {
+	active := nodes.activeNode()
+	all := nodes.all()
+	results := make([]interface{}, len(all))
+	errs := make([]error, len(all))
+
+	var wg sync.WaitGroup
+	for i, node := range all {
+		results[i] = reflect.New(reflect.TypeOf(result).Elem()).Interface()
+		wg.Add(1)
+		go func(i int, node *ethNode) {
+			defer wg.Done()
+			if node == active {
+				errs[i] = node.client.Call(results[i], method, args...)
+			} else {
+				errs[i] = callWithTimeout(node.client, nodes.config.EthNodeMaxLatency(), results[i], method, args...)
+			}
+		}(i, node)
+	}
+	wg.Wait()
+
+	var activeErr error
+	accepted := -1
+	for i, node := range all {
+		if node == active {
+			activeErr = errs[i]
+		} else if errs[i] != nil {
+			logger.Debugw(fmt.Sprintf("Ethereum node %s rejected %s", node.url, method), "err", errs[i])
+		}
+		if errs[i] == nil && (accepted == -1 || node == active) {
+			accepted = i
+		}
+	}
+
+	if activeErr != nil && !nodes.unreachable(active, activeErr) {
+		return activeErr
+	}
+	if accepted == -1 {
+		return activeErr
+	}
+	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(results[accepted]).Elem())
+	return nil
+}
+
+// EthSubscribe registers a subscription on the active node, which errors
+// with ErrEthNodeSwitched when another node becomes active.
+func (nodes *EthNodes) EthSubscribe(ctx context.Context, channel interface{}, args ...interface{}) (models.EthSubscription, error) {
+	active := nodes.activeNode()
+	sub, err := active.client.EthSubscribe(ctx, channel, args...)
+	if err != nil {
+		nodes.unreachable(active, err)
+		return nil, err
+	}
+
+	wrapped := newEthNodeSubscription(sub, nodes)
+	nodes.mutex.Lock()
+	defer nodes.mutex.Unlock()
+	if nodes.active != active {
+		wrapped.fail(ErrEthNodeSwitched)
+	} else {
+		nodes.subscriptions[wrapped] = struct{}{}
+	}
+	return wrapped, nil
+}
+
+// unreachable marks the node unhealthy and fails over if the error is not
+// one returned by the node itself, or a subscription the node cannot
+// support, returning true if so. Nodes are never marked unhealthy when there
+// is no other node to fail over to.
+func (nodes *EthNodes) unreachable(node *ethNode, err error) bool {
+	switch err.(type) {
+	case rpc.Error, UnsupportedSubscriptionError:
+		return false
+	}
+	if len(nodes.primaries) < 2 {
+		return true
+	}
+
+	node.markUnhealthy(err)
+	nodes.failover()
+	return true
+}
+
+// failover makes the highest priority healthy node active, failing the
+// subscriptions made on the previously active node.
+func (nodes *EthNodes) failover() {
+	nodes.mutex.Lock()
+	defer nodes.mutex.Unlock()
+
+	next := nodes.active
+	for _, node := range nodes.primaries {
+		if node.Status().Healthy {
+			next = node
+			break
+		}
+	}
+	if next == nodes.active {
+		return
+	}
+
+	logger.Warnw(
+		fmt.Sprintf("Switching from Ethereum node %s to %s", nodes.active.url, next.url),
+		"err", nodes.active.Status().Error,
+	)
+	nodes.active = next
+	for sub := range nodes.subscriptions {
+		sub.fail(ErrEthNodeSwitched)
+	}
+	nodes.subscriptions = map[*ethNodeSubscription]struct{}{}
+}
+
+func (nodes *EthNodes) activeNode() *ethNode {
+	nodes.mutex.RLock()
+	defer nodes.mutex.RUnlock()
+	return nodes.active
+}
+
+func (nodes *EthNodes) all() []*ethNode {
+	return append(append([]*ethNode{}, nodes.primaries...), nodes.sendOnly...)
+}
+
+func (nodes *EthNodes) forget(sub *ethNodeSubscription) {
+	nodes.mutex.Lock()
+	defer nodes.mutex.Unlock()
+	delete(nodes.subscriptions, sub)
+}
+
+type ethNode struct {
+	url      string
+	sendOnly bool
+	client   CallerSubscriber
+	mutex    sync.RWMutex
+	status   models.EthNodeStatus
+}
+
+func dialEthNode(dialer Dialer, url string, priority int, sendOnly bool) (*ethNode, error) {
+	client, err := dialer.Dial(url)
+	if err != nil {
+		return nil, err
+	}
+	return &ethNode{
+		url:      url,
+		sendOnly: sendOnly,
+		client:   client,
+		status: models.EthNodeStatus{
+			URL:      url,
+			Priority: priority,
+			SendOnly: sendOnly,
+			Healthy:  true,
+		},
+	}, nil
+}
+
+// Status returns the result of the latest health check of the node.
+func (node *ethNode) Status() models.EthNodeStatus {
+	node.mutex.RLock()
+	defer node.mutex.RUnlock()
+	return node.status
+}
+
+func (node *ethNode) markUnhealthy(err error) {
+	node.mutex.Lock()
+	defer node.mutex.Unlock()
+	node.status.Healthy = false
+	node.status.Error = err.Error()
+}
+
+func (node *ethNode) checkHealth(config Config) {
+	var header models.BlockHeader
+	var version string
+	var err error
+	start := time.Now()
+	if node.sendOnly {
+		err = callWithTimeout(node.client, config.EthNodeMaxLatency(), &version, "net_version")
+	} else {
+		err = callWithTimeout(node.client, config.EthNodeMaxLatency(), &header, "eth_getBlockByNumber", "latest", false)
+	}
+	now := time.Now()
+
+	node.mutex.Lock()
+	defer node.mutex.Unlock()
+	status := &node.status
+	status.LastCheckedAt = &now
+	status.Latency = models.Duration(now.Sub(start))
+	status.Error = ""
+	if err != nil {
+		status.Error = err.Error()
+	} else if !node.sendOnly {
+		status.HeadNumber = header.Number.ToInt().Uint64()
+		status.HeadAge = models.Duration(now.Sub(time.Unix(header.Time.ToInt().Int64(), 0)))
+		if status.HeadAge.Duration() > config.EthNodeMaxHeadAge() {
+			status.Error = fmt.Sprintf("latest block %v is %v old", status.HeadNumber, status.HeadAge)
+		}
+	}
+	status.Healthy = status.Error == ""
+}
+
+func callWithTimeout(client CallerSubscriber, timeout time.Duration, result interface{}, method string, args ...interface{}) error {
+	errs := make(chan error, 1)
+	go func() {
+		errs <- client.Call(result, method, args...)
+	}()
+
+	select {
+	case err := <-errs:
+		return err
+	case <-time.After(timeout):
+		return fmt.Errorf("%s timed out after %v", method, timeout)
+	}
+}
+
+// ethNodeSubscription forwards the errors of a subscription made on a node,
+// and is failed with ErrEthNodeSwitched when another node becomes active.
+type ethNodeSubscription struct {
+	models.EthSubscription
+	nodes        *EthNodes
+	errors       chan error
+	unsubscribed chan struct{}
+	once         sync.Once
+}
+
+func newEthNodeSubscription(sub models.EthSubscription, nodes *EthNodes) *ethNodeSubscription {
+	wrapped := &ethNodeSubscription{
+		EthSubscription: sub,
+		nodes:           nodes,
+		errors:          make(chan error, 1),
+		unsubscribed:    make(chan struct{}),
+	}
+	go wrapped.forwardErrors()
+	return wrapped
+}
+
+func (sub *ethNodeSubscription) forwardErrors() {
+	for {
+		select {
+		case err, ok := <-sub.EthSubscription.Err():
+			if !ok {
+				return
+			}
+			sub.fail(err)
+		case <-sub.unsubscribed:
+			return
+		}
+	}
+}
+
+func (sub *ethNodeSubscription) fail(err error) {
+	select {
+	case sub.errors <- err:
+	default:
+	}
+}
+
+// Err returns the channel errors are sent on.
+func (sub *ethNodeSubscription) Err() <-chan error {
+	return sub.errors
+}
+
+// Unsubscribe unsubscribes from the node the subscription was made on.
+func (sub *ethNodeSubscription) Unsubscribe() {
+	sub.once.Do(func() {
+		close(sub.unsubscribed)
+		sub.EthSubscription.Unsubscribe()
+		sub.nodes.forget(sub)
+	})
+}
@@ -0,0 +1,178 @@
+package store_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/internal/cltest"
+	"github.com/smartcontractkit/chainlink/store"
+	"github.com/smartcontractkit/chainlink/store/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type ethMockDialer map[string]*cltest.EthMock
+
+func (d ethMockDialer) Dial(url string) (store.CallerSubscriber, error) {
+	return d[url], nil
+}
+
+func newEthNodes(t *testing.T) (*store.EthNodes, *cltest.EthMock, *cltest.EthMock, *cltest.EthMock, func()) {
+	config, cleanup := cltest.NewConfig()
+	config.Set("ETH_URL", "ws://primary")
+	config.Set("ETH_SECONDARY_URLS", "ws://secondary")
+	config.Set("ETH_SENDONLY_URLS", "ws://sendonly")
+
+	primary, secondary, sendOnly := &cltest.EthMock{}, &cltest.EthMock{}, &cltest.EthMock{}
+	nodes, err := store.NewEthNodes(config.Config, ethMockDialer{
+		"ws://primary":   primary,
+		"ws://secondary": secondary,
+		"ws://sendonly":  sendOnly,
+	})
+	require.NoError(t, err)
+	return nodes, primary, secondary, sendOnly, cleanup
+}
+
+func registerHealthCheck(eth *cltest.EthMock, headAge time.Duration) {
+	eth.Register("eth_getBlockByNumber", models.BlockHeader{
+		Number: cltest.BigHexInt(10),
+		Time:   cltest.BigHexInt(time.Now().Add(-headAge).Unix()),
+	})
+}
+
+func TestEthNodes_CheckHealth(t *testing.T) {
+	t.Parallel()
+
+	nodes, primary, secondary, sendOnly, cleanup := newEthNodes(t)
+	defer cleanup()
+
+	registerHealthCheck(primary, time.Hour)
+	registerHealthCheck(secondary, 0)
+	sendOnly.Register("net_version", "1")
+	nodes.CheckHealth()
+
+	statuses := nodes.Statuses()
+	require.Len(t, statuses, 3)
+	assert.Equal(t, "ws://primary", statuses[0].URL)
+	assert.False(t, statuses[0].Healthy)
+	assert.False(t, statuses[0].Active)
+	assert.Contains(t, statuses[0].Error, "latest block 10 is")
+	assert.Equal(t, "ws://secondary", statuses[1].URL)
+	assert.True(t, statuses[1].Healthy)
+	assert.True(t, statuses[1].Active)
+	assert.Equal(t, uint64(10), statuses[1].HeadNumber)
+	assert.Equal(t, "ws://sendonly", statuses[2].URL)
+	assert.True(t, statuses[2].SendOnly)
+	assert.True(t, statuses[2].Healthy)
+	assert.False(t, statuses[2].Active)
+	assert.Equal(t, "ws://secondary", nodes.ActiveURL())
+
+	secondary.Register("eth_blockNumber", "0x1")
+	var result string
+	require.NoError(t, nodes.Call(&result, "eth_blockNumber"))
+	assert.Equal(t, "0x1", result)
+
+	registerHealthCheck(primary, 0)
+	registerHealthCheck(secondary, 0)
+	sendOnly.Register("net_version", "1")
+	nodes.CheckHealth()
+	assert.Equal(t, "ws://primary", nodes.ActiveURL())
+
+	assert.True(t, primary.AllCalled())
+	assert.True(t, secondary.AllCalled())
+	assert.True(t, sendOnly.AllCalled())
+}
+
+func TestEthNodes_Call_FailsOverWhenUnreachable(t *testing.T) {
+	t.Parallel()
+
+	nodes, primary, secondary, _, cleanup := newEthNodes(t)
+	defer cleanup()
+
+	primary.RegisterError("eth_blockNumber", "connection refused")
+	secondary.Register("eth_blockNumber", "0x2")
+
+	var result string
+	require.NoError(t, nodes.Call(&result, "eth_blockNumber"))
+	assert.Equal(t, "0x2", result)
+	assert.Equal(t, "ws://secondary", nodes.ActiveURL())
+	assert.Equal(t, "connection refused", nodes.Statuses()[0].Error)
+	assert.True(t, primary.AllCalled())
+	assert.True(t, secondary.AllCalled())
+}
+
+func TestEthNodes_SendRawTx_BroadcastsToEveryNode(t *testing.T) {
+	t.Parallel()
+
+	hash := cltest.NewHash()
+	tests := []struct {
+		name           string
+		primaryFails   bool
+		primaryRejects bool
+	}{
+		{"all accept", false, false},
+		{"primary unreachable", true, false},
+		{"primary rejects", false, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			nodes, primary, secondary, sendOnly, cleanup := newEthNodes(t)
+			defer cleanup()
+
+			if test.primaryFails {
+				primary.RegisterError("eth_sendRawTransaction", "connection refused")
+			} else if test.primaryRejects {
+				primary.RegisterRPCError("eth_sendRawTransaction", -32000, "nonce too low")
+			} else {
+				primary.Register("eth_sendRawTransaction", hash)
+			}
+			secondary.Register("eth_sendRawTransaction", hash)
+			sendOnly.Register("eth_sendRawTransaction", hash)
+
+			eth := &store.EthClient{CallerSubscriber: nodes}
+			result, err := eth.SendRawTx("0xdeadbeef")
+			if test.primaryRejects {
+				assert.EqualError(t, err, "nonce too low")
+				assert.Equal(t, "ws://primary", nodes.ActiveURL())
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, hash, result)
+			}
+			assert.True(t, primary.AllCalled())
+			assert.True(t, secondary.AllCalled())
+			assert.True(t, sendOnly.AllCalled())
+		})
+	}
+}
+
+func TestEthNodes_EthSubscribe_FailsWhenSwitchingNode(t *testing.T) {
+	t.Parallel()
+
+	nodes, primary, secondary, sendOnly, cleanup := newEthNodes(t)
+	defer cleanup()
+
+	primary.RegisterNewHeads()
+	eth := &store.EthClient{CallerSubscriber: nodes}
+	sub, err := eth.SubscribeToNewHeads(make(chan models.BlockHeader))
+	require.NoError(t, err)
+
+	registerHealthCheck(primary, time.Hour)
+	registerHealthCheck(secondary, 0)
+	sendOnly.Register("net_version", "1")
+	nodes.CheckHealth()
+
+	select {
+	case err := <-sub.Err():
+		assert.Equal(t, store.ErrEthNodeSwitched, err)
+	case <-time.After(time.Second):
+		t.Fatal("subscription did not error when switching node")
+	}
+	sub.Unsubscribe()
+
+	secondary.RegisterNewHeads()
+	sub, err = eth.SubscribeToNewHeads(make(chan models.BlockHeader))
+	require.NoError(t, err)
+	defer sub.Unsubscribe()
+	assert.True(t, secondary.AllCalled())
+}
@@ -1,6 +1,12 @@
 package store
 
+import "time"
+
 func ExportedSetTxManagerDev(txm TxManager, dev bool) {
 	typed := txm.(*EthTxManager)
 	typed.config.Set("CHAINLINK_DEV", dev)
 }
+
+func ExportedNewPollingSubscriber(caller CallerSubscriber, interval time.Duration, reorgDepth uint64) CallerSubscriber {
+	return newPollingSubscriber(caller, interval, reorgDepth)
+}
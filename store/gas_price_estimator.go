@@ -0,0 +1,180 @@
+package store
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/smartcontractkit/chainlink/logger"
+	"github.com/smartcontractkit/chainlink/store/models"
+)
+
+const (
+	// GasPriceEstimatorFixed always uses ETH_GAS_PRICE_DEFAULT.
+	GasPriceEstimatorFixed = "fixed"
+	// GasPriceEstimatorPercentile uses a percentile of the gas prices paid
+	// in recent blocks.
+	GasPriceEstimatorPercentile = "percentile"
+	// GasPriceEstimatorRPC uses the gas price suggested by the node.
+	GasPriceEstimatorRPC = "rpc"
+)
+
+// GasPriceEstimator is a HeadTrackable which estimates the gas price of
+// outgoing transactions on each new head, as configured by
+// ETH_GAS_PRICE_ESTIMATOR.
+type GasPriceEstimator struct {
+	txm         *EthTxManager
+	mutex       sync.RWMutex
+	samples     [][]*big.Int
+	estimate    *big.Int
+	blockNumber uint64
+}
+
+func newGasPriceEstimator(txm *EthTxManager) *GasPriceEstimator {
+	return &GasPriceEstimator{txm: txm}
+}
+
+// Connect does nothing; exists to comply with interface.
+func (gpe *GasPriceEstimator) Connect(*models.IndexableBlockNumber) error { return nil }
+
+// Disconnect does nothing; exists to comply with interface.
+func (gpe *GasPriceEstimator) Disconnect() {}
+
+// OnReorg does nothing, since orphaned blocks still reflect the gas prices
+// being paid.
+func (gpe *GasPriceEstimator) OnReorg(*models.Reorg) {}
+
+// OnNewHead updates the estimate from the new head, or from the node's
+// suggested gas price.
+func (gpe *GasPriceEstimator) OnNewHead(head *models.BlockHeader) {
+	config := gpe.txm.config
+	var estimate *big.Int
+	var err error
+	switch config.EthGasPriceEstimator() {
+	case GasPriceEstimatorPercentile:
+		estimate, err = gpe.sampleBlock(head)
+	case GasPriceEstimatorRPC:
+		estimate, err = gpe.txm.GetGasPrice()
+	case GasPriceEstimatorFixed:
+		return
+	default:
+		err = fmt.Errorf("unknown gas price estimator %q", config.EthGasPriceEstimator())
+	}
+
+	if err != nil {
+		logger.Warnw("Unable to estimate gas price", "err", err)
+		return
+	}
+	if estimate == nil {
+		return
+	}
+
+	gpe.mutex.Lock()
+	defer gpe.mutex.Unlock()
+	gpe.estimate = estimate
+	gpe.blockNumber = head.Number.ToInt().Uint64()
+}
+
+// sampleBlock adds the gas prices paid in the head's block to the samples
+// and returns their ETH_GAS_PRICE_PERCENTILE percentile, or nil if there
+// are no samples.
+func (gpe *GasPriceEstimator) sampleBlock(head *models.BlockHeader) (*big.Int, error) {
+	block, err := gpe.txm.GetBlockWithTransactions(hexutil.EncodeBig(head.Number.ToInt()))
+	if err != nil {
+		return nil, err
+	}
+
+	var prices []*big.Int
+	for _, tx := range block.Transactions {
+		if tx.GasPrice != nil {
+			prices = append(prices, tx.GasPrice.ToInt())
+		}
+	}
+
+	gpe.mutex.Lock()
+	defer gpe.mutex.Unlock()
+	gpe.samples = append(gpe.samples, prices)
+	if history := int(gpe.txm.config.EthGasPriceBlockHistory()); len(gpe.samples) > history {
+		gpe.samples = gpe.samples[len(gpe.samples)-history:]
+	}
+
+	var all []*big.Int
+	for _, sample := range gpe.samples {
+		all = append(all, sample...)
+	}
+	return percentile(all, gpe.txm.config.EthGasPricePercentile()), nil
+}
+
+func percentile(values []*big.Int, p uint64) *big.Int {
+	if len(values) == 0 {
+		return nil
+	}
+	if p > 100 {
+		p = 100
+	}
+
+	sorted := append([]*big.Int{}, values...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Cmp(sorted[j]) < 0 })
+	return sorted[(len(sorted)-1)*int(p)/100]
+}
+
+// Estimate returns the gas price to send transactions with. Estimates are
+// multiplied by ETH_GAS_PRICE_MULTIPLIER and kept between ETH_GAS_PRICE_MIN
+// and ETH_GAS_PRICE_MAX. ETH_GAS_PRICE_DEFAULT is used until the first
+// estimate, and always by the fixed estimator.
+func (gpe *GasPriceEstimator) Estimate() models.GasPriceEstimate {
+	config := gpe.txm.config
+	gpe.mutex.RLock()
+	defer gpe.mutex.RUnlock()
+
+	estimate := models.GasPriceEstimate{
+		Estimator:   config.EthGasPriceEstimator(),
+		GasPrice:    config.EthGasPriceDefault(),
+		Min:         config.EthGasPriceMin(),
+		Max:         config.EthGasPriceMax(),
+		Multiplier:  config.EthGasPriceMultiplier(),
+		BlockNumber: gpe.blockNumber,
+	}
+	if gpe.estimate == nil || estimate.Estimator == GasPriceEstimatorFixed {
+		return estimate
+	}
+
+	multiplied, _ := new(big.Float).Mul(
+		new(big.Float).SetInt(gpe.estimate),
+		big.NewFloat(estimate.Multiplier),
+	).Int(nil)
+	estimate.GasPrice = clampGasPrice(multiplied, estimate.Min, estimate.Max)
+	return estimate
+}
+
+// GasPrice returns the gas price to send transactions with.
+func (gpe *GasPriceEstimator) GasPrice() *big.Int {
+	return gpe.Estimate().GasPrice
+}
+
+// BumpedGasPrice returns the gas price to replace a transaction sent with
+// the given gas price with: ETH_GAS_BUMP_WEI more, or the current estimate
+// if that is higher, but never more than ETH_GAS_PRICE_MAX.
+func (gpe *GasPriceEstimator) BumpedGasPrice(gasPrice *big.Int) *big.Int {
+	config := gpe.txm.config
+	bumped := new(big.Int).Add(gasPrice, config.EthGasBumpWei())
+	if estimate := gpe.GasPrice(); estimate.Cmp(bumped) > 0 {
+		bumped = estimate
+	}
+	if max := config.EthGasPriceMax(); bumped.Cmp(max) > 0 {
+		return max
+	}
+	return bumped
+}
+
+func clampGasPrice(gasPrice, min, max *big.Int) *big.Int {
+	if gasPrice.Cmp(min) < 0 {
+		return min
+	}
+	if gasPrice.Cmp(max) > 0 {
+		return max
+	}
+	return gasPrice
+}
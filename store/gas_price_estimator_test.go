@@ -0,0 +1,108 @@
+package store_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/smartcontractkit/chainlink/internal/cltest"
+	"github.com/smartcontractkit/chainlink/store/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func gwei(n int64) *big.Int {
+	return new(big.Int).Mul(big.NewInt(n), big.NewInt(1000000000))
+}
+
+func blockWithGasPrices(prices ...int64) models.Block {
+	block := models.Block{}
+	for _, price := range prices {
+		gasPrice := hexutil.Big(*gwei(price))
+		block.Transactions = append(block.Transactions, models.PendingTransaction{GasPrice: &gasPrice})
+	}
+	return block
+}
+
+func TestGasPriceEstimator_Percentile(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+	store.Config.Set("ETH_GAS_PRICE_ESTIMATOR", "percentile")
+	store.Config.Set("ETH_GAS_PRICE_PERCENTILE", 50)
+	store.Config.Set("ETH_GAS_PRICE_BLOCK_HISTORY", 2)
+	eth := cltest.MockEthOnStore(store)
+	estimator := store.GasPriceEstimator
+
+	assert.Equal(t, store.Config.EthGasPriceDefault(), estimator.GasPrice())
+
+	eth.Register("eth_getBlockByNumber", blockWithGasPrices(10, 20, 30))
+	estimator.OnNewHead(&models.BlockHeader{Number: cltest.BigHexInt(1)})
+	assert.Equal(t, gwei(20), estimator.GasPrice())
+
+	eth.Register("eth_getBlockByNumber", blockWithGasPrices(40, 50, 60, 70))
+	estimator.OnNewHead(&models.BlockHeader{Number: cltest.BigHexInt(2)})
+	assert.Equal(t, gwei(40), estimator.GasPrice())
+
+	eth.Register("eth_getBlockByNumber", blockWithGasPrices(2, 2))
+	estimator.OnNewHead(&models.BlockHeader{Number: cltest.BigHexInt(3)})
+	assert.Equal(t, gwei(40), estimator.GasPrice())
+	assert.Equal(t, uint64(3), estimator.Estimate().BlockNumber)
+
+	eth.Register("eth_getBlockByNumber", blockWithGasPrices())
+	estimator.OnNewHead(&models.BlockHeader{Number: cltest.BigHexInt(4)})
+	assert.Equal(t, gwei(2), estimator.GasPrice())
+	assert.True(t, eth.AllCalled())
+}
+
+func TestGasPriceEstimator_MultiplierAndLimits(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		suggested  int64
+		multiplier float64
+		want       *big.Int
+	}{
+		{"multiplied", 10, 1.5, gwei(15)},
+		{"below min", 1, 1, gwei(5)},
+		{"above max", 80, 1.5, gwei(100)},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			store, cleanup := cltest.NewStore()
+			defer cleanup()
+			store.Config.Set("ETH_GAS_PRICE_ESTIMATOR", "rpc")
+			store.Config.Set("ETH_GAS_PRICE_MULTIPLIER", test.multiplier)
+			store.Config.Set("ETH_GAS_PRICE_MIN", gwei(5).String())
+			store.Config.Set("ETH_GAS_PRICE_MAX", gwei(100).String())
+			eth := cltest.MockEthOnStore(store)
+
+			eth.Register("eth_gasPrice", hexutil.Big(*gwei(test.suggested)))
+			store.GasPriceEstimator.OnNewHead(&models.BlockHeader{Number: cltest.BigHexInt(1)})
+			assert.Equal(t, test.want, store.GasPriceEstimator.GasPrice())
+			assert.True(t, eth.AllCalled())
+		})
+	}
+}
+
+func TestGasPriceEstimator_BumpedGasPrice(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+	store.Config.Set("ETH_GAS_PRICE_ESTIMATOR", "rpc")
+	store.Config.Set("ETH_GAS_BUMP_WEI", gwei(5).String())
+	store.Config.Set("ETH_GAS_PRICE_MAX", gwei(100).String())
+	eth := cltest.MockEthOnStore(store)
+	estimator := store.GasPriceEstimator
+
+	assert.Equal(t, gwei(25), estimator.BumpedGasPrice(gwei(20)))
+	assert.Equal(t, gwei(100), estimator.BumpedGasPrice(gwei(98)))
+	assert.Equal(t, gwei(100), estimator.BumpedGasPrice(gwei(100)))
+
+	eth.Register("eth_gasPrice", hexutil.Big(*gwei(50)))
+	estimator.OnNewHead(&models.BlockHeader{Number: cltest.BigHexInt(1)})
+	assert.Equal(t, gwei(50), estimator.BumpedGasPrice(gwei(20)))
+}
@@ -1,33 +1,56 @@
 package store
 
 import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
 	"errors"
 	"fmt"
+	"io"
 	"math/big"
+	"sync"
 
 	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/smartcontractkit/chainlink/logger"
 	"github.com/smartcontractkit/chainlink/store/models"
 	"github.com/smartcontractkit/chainlink/utils"
 	"go.uber.org/multierr"
+	"golang.org/x/crypto/scrypt"
+)
+
+// ErrSecretsLocked is returned when encrypting or decrypting a secret before
+// the keystore has been unlocked.
+var ErrSecretsLocked = errors.New("keystore must be unlocked to use secrets")
+
+const (
+	secretsScryptN = 1 << 15
+	secretsScryptR = 8
+	secretsScryptP = 1
+	secretsKeyLen  = 32
 )
 
 // KeyStore manages a key storage directory on disk.
 type KeyStore struct {
 	*keystore.KeyStore
+	secretsSalt  func() ([]byte, error)
+	secretsMutex sync.RWMutex
+	secretsKey   []byte
 }
 
-// NewKeyStore creates a keystore for the given directory.
-func NewKeyStore(keyDir string) *KeyStore {
+// NewKeyStore creates a keystore for the given directory. The key encrypting
+// secrets is derived from the keystore password and the salt returned by
+// secretsSalt.
+func NewKeyStore(keyDir string, secretsSalt func() ([]byte, error)) *KeyStore {
 	ks := keystore.NewKeyStore(
 		keyDir,
 		keystore.StandardScryptN,
 		keystore.StandardScryptP,
 	)
 
-	return &KeyStore{ks}
+	return &KeyStore{KeyStore: ks, secretsSalt: secretsSalt}
 }
 
 // HasAccounts returns true if there are accounts located at the keystore
@@ -48,7 +71,10 @@ func (ks *KeyStore) Unlock(phrase string) error {
 			logger.Infow(fmt.Sprint("Unlocked account ", account.Address.Hex()), "address", account.Address.Hex())
 		}
 	}
-	return merr
+	if merr != nil {
+		return merr
+	}
+	return ks.deriveSecretsKey(phrase)
 }
 
 // NewAccount adds an account to the keystore
@@ -63,9 +89,55 @@ func (ks *KeyStore) NewAccount(passphrase string) (accounts.Account, error) {
 		return accounts.Account{}, err
 	}
 
+	if !ks.secretsUnlocked() {
+		if err := ks.deriveSecretsKey(passphrase); err != nil {
+			return accounts.Account{}, err
+		}
+	}
 	return account, nil
 }
 
+// Import adds the account in the encrypted JSON key to the keystore,
+// re-encrypting it with the passphrase, and unlocks it.
+func (ks *KeyStore) Import(keyJSON []byte, keyPassphrase, passphrase string) (accounts.Account, error) {
+	account, err := ks.KeyStore.Import(keyJSON, keyPassphrase, passphrase)
+	if err != nil {
+		return accounts.Account{}, err
+	}
+
+	err = ks.KeyStore.Unlock(account, passphrase)
+	if err != nil {
+		return accounts.Account{}, err
+	}
+
+	if !ks.secretsUnlocked() {
+		if err := ks.deriveSecretsKey(passphrase); err != nil {
+			return accounts.Account{}, err
+		}
+	}
+	return account, nil
+}
+
+// Export returns the key of the account with the given address as JSON,
+// encrypted with the new passphrase.
+func (ks *KeyStore) Export(address common.Address, passphrase, newPassphrase string) ([]byte, error) {
+	account, err := ks.Find(accounts.Account{Address: address})
+	if err != nil {
+		return nil, err
+	}
+	return ks.KeyStore.Export(account, passphrase, newPassphrase)
+}
+
+// Delete removes the key of the account with the given address from the
+// keystore directory, if the passphrase decrypts it.
+func (ks *KeyStore) Delete(address common.Address, passphrase string) error {
+	account, err := ks.Find(accounts.Account{Address: address})
+	if err != nil {
+		return err
+	}
+	return ks.KeyStore.Delete(account, passphrase)
+}
+
 // SignTx uses the unlocked account to sign the given transaction.
 func (ks *KeyStore) SignTx(account accounts.Account, tx *types.Transaction, chainID uint64) (*types.Transaction, error) {
 	return ks.KeyStore.SignTx(
@@ -103,3 +175,72 @@ func (ks *KeyStore) GetFirstAccount() (accounts.Account, error) {
 	}
 	return ks.Accounts()[0], nil
 }
+
+// EncryptSecret seals the plaintext with the key derived from the keystore
+// password, returning the nonce followed by the ciphertext.
+func (ks *KeyStore) EncryptSecret(plaintext []byte) ([]byte, error) {
+	aead, err := ks.secretsCipher()
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+// DecryptSecret opens a secret sealed by EncryptSecret.
+func (ks *KeyStore) DecryptSecret(sealed []byte) ([]byte, error) {
+	aead, err := ks.secretsCipher()
+	if err != nil {
+		return nil, err
+	}
+	if len(sealed) < aead.NonceSize() {
+		return nil, errors.New("secret ciphertext is too short")
+	}
+	nonce, ciphertext := sealed[:aead.NonceSize()], sealed[aead.NonceSize():]
+	return aead.Open(nil, nonce, ciphertext, nil)
+}
+
+// deriveSecretsKey derives the key used to encrypt secrets from the keystore
+// password, salted with a random salt so that nodes sharing a password do not
+// share a key.
+func (ks *KeyStore) deriveSecretsKey(phrase string) error {
+	if !ks.HasAccounts() {
+		// The key is derived once an account has confirmed the password.
+		return nil
+	}
+	salt, err := ks.secretsSalt()
+	if err != nil {
+		return fmt.Errorf("loading secrets salt: %v", err)
+	}
+	key, err := scrypt.Key([]byte(phrase), salt, secretsScryptN, secretsScryptR, secretsScryptP, secretsKeyLen)
+	if err != nil {
+		return err
+	}
+
+	ks.secretsMutex.Lock()
+	defer ks.secretsMutex.Unlock()
+	ks.secretsKey = key
+	return nil
+}
+
+func (ks *KeyStore) secretsUnlocked() bool {
+	ks.secretsMutex.RLock()
+	defer ks.secretsMutex.RUnlock()
+	return ks.secretsKey != nil
+}
+
+func (ks *KeyStore) secretsCipher() (cipher.AEAD, error) {
+	ks.secretsMutex.RLock()
+	defer ks.secretsMutex.RUnlock()
+	if ks.secretsKey == nil {
+		return nil, ErrSecretsLocked
+	}
+	block, err := aes.NewCipher(ks.secretsKey)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
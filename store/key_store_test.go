@@ -5,7 +5,9 @@ import (
 	"testing"
 
 	"github.com/smartcontractkit/chainlink/internal/cltest"
+	strpkg "github.com/smartcontractkit/chainlink/store"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 const correctPassphrase = "p@ssword"
@@ -91,3 +93,60 @@ func TestKeyStore_SignAccountLocked(t *testing.T) {
 	_, err = store.KeyStore.Sign([]byte("abc123"))
 	assert.Error(t, err)
 }
+
+func TestKeyStore_EncryptSecret(t *testing.T) {
+	t.Parallel()
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	_, err := store.KeyStore.EncryptSecret([]byte("api key"))
+	assert.Equal(t, strpkg.ErrSecretsLocked, err)
+
+	_, err = store.KeyStore.NewAccount(correctPassphrase)
+	require.NoError(t, err)
+	require.NoError(t, store.KeyStore.Unlock(correctPassphrase))
+
+	sealed, err := store.KeyStore.EncryptSecret([]byte("api key"))
+	require.NoError(t, err)
+	assert.NotContains(t, string(sealed), "api key")
+
+	plaintext, err := store.KeyStore.DecryptSecret(sealed)
+	require.NoError(t, err)
+	assert.Equal(t, "api key", string(plaintext))
+
+	_, err = store.KeyStore.NewAccount(correctPassphrase)
+	require.NoError(t, err)
+	require.NoError(t, store.KeyStore.Unlock(correctPassphrase))
+	plaintext, err = store.KeyStore.DecryptSecret(sealed)
+	require.NoError(t, err, "adding an account should not change the secrets key")
+	assert.Equal(t, "api key", string(plaintext))
+
+	sealed[len(sealed)-1] ^= 0xff
+	_, err = store.KeyStore.DecryptSecret(sealed)
+	assert.Error(t, err)
+}
+
+func TestKeyStore_ImportExport(t *testing.T) {
+	t.Parallel()
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	account, err := store.KeyStore.NewAccount(correctPassphrase)
+	require.NoError(t, err)
+
+	keyJSON, err := store.KeyStore.Export(account.Address, correctPassphrase, "exported")
+	require.NoError(t, err)
+
+	other, otherCleanup := cltest.NewStore()
+	defer otherCleanup()
+
+	_, err = other.KeyStore.Import(keyJSON, "wrong", correctPassphrase)
+	assert.Error(t, err)
+	imported, err := other.KeyStore.Import(keyJSON, "exported", correctPassphrase)
+	require.NoError(t, err)
+	assert.Equal(t, account.Address, imported.Address)
+	assert.NoError(t, other.KeyStore.Unlock(correctPassphrase))
+
+	_, err = store.KeyStore.Export(cltest.NewAddress(), correctPassphrase, correctPassphrase)
+	assert.Error(t, err)
+}
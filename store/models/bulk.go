@@ -36,7 +36,7 @@ type BulkDeleteRunTask struct {
 // NewBulkDeleteRunTask returns a task from a request to make a task
 func NewBulkDeleteRunTask(request BulkDeleteRunRequest) (*BulkDeleteRunTask, error) {
 	for _, status := range request.Status {
-		if status != RunStatusCompleted && status != RunStatusErrored {
+		if !status.Finished() {
 			return nil, fmt.Errorf("cannot delete Runs with status %s", status)
 		}
 	}
@@ -62,3 +62,56 @@ func (t *BulkDeleteRunTask) SetID(value string) error {
 	t.ID = value
 	return nil
 }
+
+// BackfillLogsRequest describes the block range that should be searched for
+// historical logs of a log initiated job.
+type BackfillLogsRequest struct {
+	JobID     string `json:"jobId"`
+	FromBlock uint64 `json:"fromBlock"`
+	ToBlock   uint64 `json:"toBlock"`
+}
+
+// BulkBackfillLogsTask represents a task that is working to create runs for
+// historical logs that were missed by a job's subscription.
+type BulkBackfillLogsTask struct {
+	ID           string              `json:"id" storm:"id,unique"`
+	Query        BackfillLogsRequest `json:"query"`
+	Status       BulkTaskStatus      `json:"status" storm:"index"`
+	ErrorMessage string              `json:"error,omitempty"`
+	CurrentBlock uint64              `json:"currentBlock"`
+	RunsCreated  int                 `json:"runsCreated"`
+	CreatedAt    time.Time           `json:"createdAt" storm:"index"`
+}
+
+// NewBulkBackfillLogsTask returns a task from a request to backfill logs
+func NewBulkBackfillLogsTask(request BackfillLogsRequest) (*BulkBackfillLogsTask, error) {
+	if request.JobID == "" {
+		return nil, fmt.Errorf("cannot backfill logs without a job ID")
+	}
+	if request.FromBlock > request.ToBlock {
+		return nil, fmt.Errorf("fromBlock %v cannot be after toBlock %v", request.FromBlock, request.ToBlock)
+	}
+
+	return &BulkBackfillLogsTask{
+		ID:           utils.NewBytes32ID(),
+		Query:        request,
+		CurrentBlock: request.FromBlock,
+		CreatedAt:    time.Now(),
+	}, nil
+}
+
+// GetID returns the ID of this structure for jsonapi serialization.
+func (t BulkBackfillLogsTask) GetID() string {
+	return t.ID
+}
+
+// GetName returns the pluralized "type" of this structure for jsonapi serialization.
+func (t BulkBackfillLogsTask) GetName() string {
+	return "bulk_backfill_logs_tasks"
+}
+
+// SetID is used to set the ID of this structure when deserializing from jsonapi documents.
+func (t *BulkBackfillLogsTask) SetID(value string) error {
+	t.ID = value
+	return nil
+}
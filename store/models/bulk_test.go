@@ -20,3 +20,20 @@ func TestNewBulkDeleteRunTask(t *testing.T) {
 	task, err = NewBulkDeleteRunTask(BulkDeleteRunRequest{Status: []RunStatus{RunStatusInProgress}})
 	assert.Error(t, err)
 }
+
+func TestNewBulkBackfillLogsTask(t *testing.T) {
+	task, err := NewBulkBackfillLogsTask(BackfillLogsRequest{JobID: "abc", FromBlock: 10, ToBlock: 20})
+	assert.NoError(t, err)
+	assert.NotEmpty(t, task.ID)
+	assert.Equal(t, uint64(10), task.CurrentBlock)
+	assert.Equal(t, BulkTaskStatusInProgress, task.Status)
+
+	_, err = NewBulkBackfillLogsTask(BackfillLogsRequest{JobID: "abc", FromBlock: 10, ToBlock: 10})
+	assert.NoError(t, err)
+
+	_, err = NewBulkBackfillLogsTask(BackfillLogsRequest{FromBlock: 10, ToBlock: 20})
+	assert.Error(t, err)
+
+	_, err = NewBulkBackfillLogsTask(BackfillLogsRequest{JobID: "abc", FromBlock: 21, ToBlock: 20})
+	assert.Error(t, err)
+}
@@ -35,10 +35,17 @@ const (
 	RunStatusPendingBridge = RunStatus("pending_bridge")
 	// RunStatusPendingSleep is used for when a run is waiting on a sleep function to finish.
 	RunStatusPendingSleep = RunStatus("pending_sleep")
+	// RunStatusPendingRetry is used for when a run is waiting to retry a task that errored.
+	RunStatusPendingRetry = RunStatus("pending_retry")
 	// RunStatusErrored is used for when a run has errored and will not complete.
 	RunStatusErrored = RunStatus("errored")
 	// RunStatusCompleted is used for when a run has successfully completed execution.
 	RunStatusCompleted = RunStatus("completed")
+	// RunStatusCancelled is used for when a run has been cancelled by the node operator.
+	RunStatusCancelled = RunStatus("cancelled")
+	// RunStatusExpired is used for when a run's request expired on chain before
+	// the run could fulfill it.
+	RunStatusExpired = RunStatus("expired")
 )
 
 // Unstarted returns true if the status is the initial state.
@@ -66,6 +73,11 @@ func (s RunStatus) PendingSleep() bool {
 	return s == RunStatusPendingSleep
 }
 
+// PendingRetry returns true if the status is pending_retry.
+func (s RunStatus) PendingRetry() bool {
+	return s == RunStatusPendingRetry
+}
+
 // Completed returns true if the status is RunStatusCompleted.
 func (s RunStatus) Completed() bool {
 	return s == RunStatusCompleted
@@ -78,17 +90,28 @@ func (s RunStatus) Errored() bool {
 
 // Pending returns true if the status is pending external or confirmations.
 func (s RunStatus) Pending() bool {
-	return s.PendingBridge() || s.PendingConfirmations() || s.PendingSleep() || s.PendingConnection()
+	return s.PendingBridge() || s.PendingConfirmations() || s.PendingSleep() ||
+		s.PendingConnection() || s.PendingRetry()
+}
+
+// Cancelled returns true if the status is RunStatusCancelled.
+func (s RunStatus) Cancelled() bool {
+	return s == RunStatusCancelled
+}
+
+// Expired returns true if the status is RunStatusExpired.
+func (s RunStatus) Expired() bool {
+	return s == RunStatusExpired
 }
 
 // Finished returns true if the status is final and can't be changed.
 func (s RunStatus) Finished() bool {
-	return s.Completed() || s.Errored()
+	return s.Completed() || s.Errored() || s.Cancelled() || s.Expired()
 }
 
 // Runnable returns true if the status is ready to be run.
 func (s RunStatus) Runnable() bool {
-	return !s.Errored() && !s.Pending()
+	return !s.Errored() && !s.Cancelled() && !s.Expired() && !s.Pending()
 }
 
 // CanStart returns true if the run is ready to begin processed.
@@ -292,6 +315,43 @@ func (c Cron) String() string {
 	return string(c)
 }
 
+// Duration is a time duration that is serialized as a string such as "1m30s".
+type Duration time.Duration
+
+// Duration returns the value as the standard time.Duration type.
+func (d Duration) Duration() time.Duration {
+	return time.Duration(d)
+}
+
+// String returns a string representing the duration in the form "72h3m0.5s".
+func (d Duration) String() string {
+	return time.Duration(d).String()
+}
+
+// MarshalJSON returns the duration as a JSON string.
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+// UnmarshalJSON parses a duration string such as "300ms" or "1h30m".
+func (d *Duration) UnmarshalJSON(input []byte) error {
+	var s string
+	if err := json.Unmarshal(input, &s); err != nil {
+		return fmt.Errorf("Duration: %v", err)
+	}
+	if s == "" {
+		*d = 0
+		return nil
+	}
+
+	v, err := time.ParseDuration(s)
+	if err != nil {
+		return fmt.Errorf("Duration: %v", err)
+	}
+	*d = Duration(v)
+	return nil
+}
+
 // WithdrawalRequest request to withdraw LINK.
 type WithdrawalRequest struct {
 	DestinationAddress common.Address `json:"address"`
@@ -305,12 +365,46 @@ type SendEtherRequest struct {
 	Amount             *assets.Eth    `json:"amount"`
 }
 
-// CreateKeyRequest represents a request to add an ethereum key.
+// CreateKeyRequest represents a request to add an ethereum key. The new
+// account password, if set, must be the keystore password.
 type CreateKeyRequest struct {
 	CurrentPassword    string `json:"current_password"`
 	NewAccountPassword string `json:"new_account_password"`
 }
 
+// ImportKeyRequest represents a request to import an encrypted JSON key,
+// which is re-encrypted with the keystore password.
+type ImportKeyRequest struct {
+	CurrentPassword string          `json:"current_password"`
+	KeyJSON         json.RawMessage `json:"key_json"`
+	KeyPassword     string          `json:"key_password"`
+}
+
+// ExportKeyRequest represents a request to export a key as encrypted JSON,
+// encrypted with the new password, or the keystore password if empty.
+type ExportKeyRequest struct {
+	CurrentPassword string `json:"current_password"`
+	NewPassword     string `json:"new_password"`
+}
+
+// UpdateKeyRequest represents a request to change the node's settings for a
+// key.
+type UpdateKeyRequest struct {
+	CurrentPassword string `json:"current_password"`
+	SendDisabled    bool   `json:"send_disabled"`
+}
+
+// DeleteKeyRequest represents a request to delete a key from the keystore.
+type DeleteKeyRequest struct {
+	CurrentPassword string `json:"current_password"`
+}
+
+// ReplaceTxRequest represents a request to speed up or cancel a transaction
+// by replacing it at a higher gas price.
+type ReplaceTxRequest struct {
+	GasPrice *Int `json:"gasPrice"`
+}
+
 // Int stores large integers and can deserialize a variety of inputs.
 type Int big.Int
 
@@ -68,6 +68,7 @@ type Tx struct {
 	Nonce    uint64 `storm:"index"`
 	Value    *big.Int
 	GasLimit uint64
+	Replaced bool
 	TxAttempt
 }
 
@@ -95,6 +96,9 @@ type TxAttempt struct {
 	Confirmed bool
 	Hex       string
 	SentAt    uint64
+	// Cancels is true for attempts sent to cancel the transaction, as zero
+	// value transactions to its sender.
+	Cancels bool
 }
 
 // GetID returns the ID of this structure for jsonapi serialization.
@@ -170,6 +174,59 @@ func (f *FunctionSelector) UnmarshalJSON(input []byte) error {
 	return nil
 }
 
+// MarshalJSON returns the FunctionSelector as a hex string.
+func (f FunctionSelector) MarshalJSON() ([]byte, error) {
+	return json.Marshal(f.String())
+}
+
+// Block is a block along with its transactions.
+type Block struct {
+	Number       hexutil.Big          `json:"number"`
+	Hash         common.Hash          `json:"hash"`
+	Transactions []PendingTransaction `json:"transactions"`
+}
+
+// PendingTransaction is a transaction announced by the ethereum node when it
+// enters the node's transaction pool. Nodes that do not send full transaction
+// objects on the newPendingTransactions subscription only announce its Hash.
+type PendingTransaction struct {
+	Hash     common.Hash     `json:"hash"`
+	From     common.Address  `json:"from"`
+	To       *common.Address `json:"to"`
+	Input    hexutil.Bytes   `json:"input"`
+	Value    *hexutil.Big    `json:"value"`
+	Gas      hexutil.Uint64  `json:"gas"`
+	GasPrice *hexutil.Big    `json:"gasPrice"`
+	Nonce    hexutil.Uint64  `json:"nonce"`
+}
+
+// UnmarshalJSON parses either a full transaction object or a bare
+// transaction hash.
+func (ptx *PendingTransaction) UnmarshalJSON(input []byte) error {
+	if string(input) == "null" {
+		return nil
+	}
+
+	var hash common.Hash
+	if err := json.Unmarshal(input, &hash); err == nil {
+		*ptx = PendingTransaction{Hash: hash}
+		return nil
+	}
+
+	type Alias PendingTransaction
+	var aux Alias
+	if err := json.Unmarshal(input, &aux); err != nil {
+		return err
+	}
+	*ptx = PendingTransaction(aux)
+	return nil
+}
+
+// HashOnly returns true if the node only announced the transaction's hash.
+func (ptx PendingTransaction) HashOnly() bool {
+	return ptx.From == common.Address{}
+}
+
 // BlockHeader represents a block header in the Ethereum blockchain.
 // Deliberately does not have required fields because some fields aren't
 // present depending on the Ethereum node.
@@ -262,6 +319,45 @@ func (l *IndexableBlockNumber) NextInt() *big.Int {
 	return new(big.Int).Add(l.ToInt(), big.NewInt(1))
 }
 
+// Head is a recent block header kept by the HeadTracker along with the hash
+// of its parent, so that chain reorganisations can be detected.
+type Head struct {
+	Number     uint64      `json:"number" storm:"id"`
+	Hash       common.Hash `json:"hash"`
+	ParentHash common.Hash `json:"parentHash"`
+	Time       uint64      `json:"time"`
+}
+
+// NewHead returns the Head of the block header.
+func NewHead(h BlockHeader) Head {
+	return Head{
+		Number:     h.Number.ToInt().Uint64(),
+		Hash:       h.Hash(),
+		ParentHash: h.ParentHash,
+		Time:       h.Time.ToInt().Uint64(),
+	}
+}
+
+// ToIndexableBlockNumber converts the Head to an IndexableBlockNumber.
+func (h Head) ToIndexableBlockNumber() *IndexableBlockNumber {
+	return NewIndexableBlockNumber(new(big.Int).SetUint64(h.Number), h.Hash)
+}
+
+// Reorg describes a chain reorganisation, in which the blocks after the
+// common ancestor were replaced by those of another fork.
+type Reorg struct {
+	CommonAncestor *IndexableBlockNumber `json:"commonAncestor"`
+	OldHead        *IndexableBlockNumber `json:"oldHead"`
+	NewHead        *IndexableBlockNumber `json:"newHead"`
+	Depth          uint64                `json:"depth"`
+}
+
+// Orphaned returns true if the block at the given height was replaced by the
+// reorganisation.
+func (r Reorg) Orphaned(height *big.Int) bool {
+	return height != nil && height.Cmp(r.CommonAncestor.ToInt()) > 0
+}
+
 // EthSubscription should implement Err() <-chan error and Unsubscribe()
 type EthSubscription interface {
 	Err() <-chan error
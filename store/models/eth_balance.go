@@ -0,0 +1,35 @@
+package models
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/smartcontractkit/chainlink/store/assets"
+)
+
+// EthBalance is the ETH balance of an account as of the block it was last
+// checked at, and whether it was below ETH_BALANCE_THRESHOLD.
+type EthBalance struct {
+	Address     common.Address `json:"address"`
+	Balance     *assets.Eth    `json:"balance"`
+	Low         bool           `json:"low"`
+	BlockNumber uint64         `json:"blockNumber"`
+	CheckedAt   time.Time      `json:"checkedAt"`
+}
+
+// GetID returns the ID of this structure for jsonapi serialization.
+func (eb EthBalance) GetID() string {
+	return eb.Address.Hex()
+}
+
+// GetName returns the pluralized "type" of this structure for jsonapi serialization.
+func (eb EthBalance) GetName() string {
+	return "eth_balances"
+}
+
+// SetID is used to conform to the UnmarshallIdentifier interface for
+// deserializing from jsonapi documents.
+func (eb *EthBalance) SetID(value string) error {
+	eb.Address = common.HexToAddress(value)
+	return nil
+}
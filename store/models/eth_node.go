@@ -0,0 +1,37 @@
+package models
+
+import (
+	"time"
+)
+
+// EthNodeStatus describes an Ethereum node the Chainlink node is configured
+// with and the result of its latest health check.
+type EthNodeStatus struct {
+	URL           string     `json:"url"`
+	Priority      int        `json:"priority"`
+	SendOnly      bool       `json:"sendOnly"`
+	Active        bool       `json:"active"`
+	Healthy       bool       `json:"healthy"`
+	HeadNumber    uint64     `json:"headNumber"`
+	HeadAge       Duration   `json:"headAge"`
+	Latency       Duration   `json:"latency"`
+	Error         string     `json:"error,omitempty"`
+	LastCheckedAt *time.Time `json:"lastCheckedAt"`
+}
+
+// GetID returns the ID of this structure for jsonapi serialization.
+func (s EthNodeStatus) GetID() string {
+	return s.URL
+}
+
+// GetName returns the pluralized "type" of this structure for jsonapi serialization.
+func (s EthNodeStatus) GetName() string {
+	return "eth_nodes"
+}
+
+// SetID is used to conform to the UnmarshallIdentifier interface for
+// deserializing from jsonapi documents.
+func (s *EthNodeStatus) SetID(value string) error {
+	s.URL = value
+	return nil
+}
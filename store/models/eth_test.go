@@ -6,6 +6,7 @@ import (
 	"math/big"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/smartcontractkit/chainlink/internal/cltest"
 	"github.com/smartcontractkit/chainlink/store/models"
@@ -71,6 +72,39 @@ func TestModels_FunctionSelectorUnmarshalJSONError(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestModels_PendingTransaction_UnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    string
+		hash     string
+		hashOnly bool
+	}{
+		{"hash", `"0x2a7b0aa76f88a0d5ef8ccc8ccbe1f2e8a0a4c4bf2b0e8b7a5f2c8c1c0e4d3b2a"`,
+			"0x2a7b0aa76f88a0d5ef8ccc8ccbe1f2e8a0a4c4bf2b0e8b7a5f2c8c1c0e4d3b2a", true},
+		{"full transaction", `{
+			"hash": "0x2a7b0aa76f88a0d5ef8ccc8ccbe1f2e8a0a4c4bf2b0e8b7a5f2c8c1c0e4d3b2a",
+			"from": "0x3cCad4715152693fE3BC4460591e3D3Fbd071b42",
+			"to": "0x9FBDa871d559710256a2502A2517b794B482Db40",
+			"input": "0x70a08231",
+			"value": "0x0",
+			"gas": "0x5208",
+			"gasPrice": "0x4a817c800",
+			"nonce": "0x1"
+		}`, "0x2a7b0aa76f88a0d5ef8ccc8ccbe1f2e8a0a4c4bf2b0e8b7a5f2c8c1c0e4d3b2a", false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var tx models.PendingTransaction
+			assert.NoError(t, json.Unmarshal([]byte(test.input), &tx))
+			assert.Equal(t, test.hash, tx.Hash.Hex())
+			assert.Equal(t, test.hashOnly, tx.HashOnly())
+		})
+	}
+}
+
 func TestModels_Header_UnmarshalJSON(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -164,3 +198,26 @@ func TestModels_IndexableBlockNumber_NextInt(t *testing.T) {
 		})
 	}
 }
+
+func TestReorg_Orphaned(t *testing.T) {
+	t.Parallel()
+
+	reorg := models.Reorg{CommonAncestor: models.NewIndexableBlockNumber(big.NewInt(10), common.Hash{})}
+	assert.False(t, reorg.Orphaned(big.NewInt(9)))
+	assert.False(t, reorg.Orphaned(big.NewInt(10)))
+	assert.True(t, reorg.Orphaned(big.NewInt(11)))
+	assert.False(t, reorg.Orphaned(nil))
+}
+
+func TestNewHead(t *testing.T) {
+	t.Parallel()
+
+	hash, parent := common.HexToHash("0x1"), common.HexToHash("0x2")
+	head := models.NewHead(models.BlockHeader{
+		Number:     hexutil.Big(*big.NewInt(7)),
+		GethHash:   hash,
+		ParentHash: parent,
+	})
+	assert.Equal(t, models.Head{Number: 7, Hash: hash, ParentHash: parent}, head)
+	assert.Equal(t, big.NewInt(7), head.ToIndexableBlockNumber().ToInt())
+}
@@ -0,0 +1,32 @@
+package models
+
+import (
+	"math/big"
+)
+
+// GasPriceEstimate is the gas price outgoing transactions are sent with, and
+// how it was estimated.
+type GasPriceEstimate struct {
+	Estimator   string   `json:"estimator"`
+	GasPrice    *big.Int `json:"gasPrice"`
+	Min         *big.Int `json:"min"`
+	Max         *big.Int `json:"max"`
+	Multiplier  float64  `json:"multiplier"`
+	BlockNumber uint64   `json:"blockNumber"`
+}
+
+// GetID returns the ID of this structure for jsonapi serialization.
+func (gpe GasPriceEstimate) GetID() string {
+	return "gas_price"
+}
+
+// GetName returns the pluralized "type" of this structure for jsonapi serialization.
+func (gpe GasPriceEstimate) GetName() string {
+	return "gas_prices"
+}
+
+// SetID is used to conform to the UnmarshallIdentifier interface for
+// deserializing from jsonapi documents.
+func (gpe *GasPriceEstimate) SetID(value string) error {
+	return nil
+}
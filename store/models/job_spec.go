@@ -1,6 +1,7 @@
 package models
 
 import (
+	"bytes"
 	"crypto/subtle"
 	"encoding/json"
 	"fmt"
@@ -17,18 +18,63 @@ import (
 // JobSpec is the definition for all the work to be carried out by the node
 // for a given contract. It contains the Initiators, Tasks (which are the
 // individual steps to be carried out), StartAt, EndAt, and CreatedAt fields.
+// Updating a job increments its Version, so that runs can be traced back to
+// the spec they ran with.
 type JobSpec struct {
-	ID        string `json:"id" storm:"id,unique"`
-	CreatedAt Time   `json:"createdAt" storm:"index"`
+	ID        string        `json:"id" storm:"id,unique"`
+	CreatedAt Time          `json:"createdAt" storm:"index"`
+	UpdatedAt Time          `json:"updatedAt"`
+	Version   uint64        `json:"version"`
+	Status    JobSpecStatus `json:"status"`
 	JobSpecRequest
 }
 
+// JobSpecStatus is a string that represents whether a job is initiating runs.
+type JobSpecStatus string
+
+const (
+	// JobSpecStatusActive is used for jobs that initiate runs.
+	JobSpecStatusActive = JobSpecStatus("active")
+	// JobSpecStatusPaused is used for jobs that have been paused by the node
+	// operator and will not initiate runs until resumed.
+	JobSpecStatusPaused = JobSpecStatus("paused")
+	// JobSpecStatusArchived is used for jobs that have been deleted by the
+	// node operator, keeping their run history.
+	JobSpecStatusArchived = JobSpecStatus("archived")
+)
+
+// JobSpecVersion is a snapshot of a job spec as it was before being updated.
+type JobSpecVersion struct {
+	ID      string  `json:"id" storm:"id,unique"`
+	JobID   string  `json:"jobId" storm:"index"`
+	Version uint64  `json:"version"`
+	Spec    JobSpec `json:"spec"`
+}
+
+// NewJobSpecVersion returns a snapshot of the current version of the job.
+func NewJobSpecVersion(j JobSpec) JobSpecVersion {
+	return JobSpecVersion{
+		ID:      JobSpecVersionID(j.ID, j.Version),
+		JobID:   j.ID,
+		Version: j.Version,
+		Spec:    j,
+	}
+}
+
+// JobSpecVersionID returns the ID of the snapshot of the given job version.
+func JobSpecVersionID(jobID string, version uint64) string {
+	return fmt.Sprintf("%s-%d", jobID, version)
+}
+
 // JobSpecRequest represents a schema for the incoming job spec request as used by the API.
+// A MaxConcurrentRuns of zero leaves the job's runs limited only by the
+// node's run queue.
 type JobSpecRequest struct {
-	Initiators []Initiator `json:"initiators"`
-	Tasks      []TaskSpec  `json:"tasks" storm:"inline"`
-	StartAt    null.Time   `json:"startAt" storm:"index"`
-	EndAt      null.Time   `json:"endAt" storm:"index"`
+	Initiators        []Initiator `json:"initiators"`
+	Tasks             []TaskSpec  `json:"tasks" storm:"inline"`
+	StartAt           null.Time   `json:"startAt" storm:"index"`
+	EndAt             null.Time   `json:"endAt" storm:"index"`
+	MaxConcurrentRuns uint64      `json:"maxConcurrentRuns,omitempty"`
 }
 
 // GetID returns the ID of this structure for jsonapi serialization.
@@ -50,9 +96,13 @@ func (j *JobSpec) SetID(value string) error {
 // NewJob initializes a new job by generating a unique ID and setting
 // the CreatedAt field to the time of invokation.
 func NewJob() JobSpec {
+	now := Time{Time: time.Now()}
 	return JobSpec{
 		ID:        utils.NewBytes32ID(),
-		CreatedAt: Time{Time: time.Now()},
+		CreatedAt: now,
+		UpdatedAt: now,
+		Version:   1,
+		Status:    JobSpecStatusActive,
 	}
 }
 
@@ -64,9 +114,40 @@ func NewJobFromRequest(jsr JobSpecRequest) JobSpec {
 	jobSpec.Tasks = jsr.Tasks
 	jobSpec.EndAt = jsr.EndAt
 	jobSpec.StartAt = jsr.StartAt
+	jobSpec.MaxConcurrentRuns = jsr.MaxConcurrentRuns
 	return jobSpec
 }
 
+// Update returns the next version of the job, replacing its initiators,
+// tasks and schedule with those of the passed request.
+func (j JobSpec) Update(jsr JobSpecRequest, now time.Time) JobSpec {
+	next := j
+	next.JobSpecRequest = jsr
+	for i := range next.Initiators {
+		next.Initiators[i].ID = 0
+		next.Initiators[i].JobID = j.ID
+	}
+	next.Version = j.Version + 1
+	next.UpdatedAt = Time{Time: now}
+	return next
+}
+
+// Archived returns true if the job has been deleted by the node operator.
+func (j JobSpec) Archived() bool {
+	return j.Status == JobSpecStatusArchived
+}
+
+// Paused returns true if the job has been paused by the node operator.
+func (j JobSpec) Paused() bool {
+	return j.Status == JobSpecStatusPaused
+}
+
+// Active returns true if the job initiates runs. Jobs saved before job
+// statuses were introduced are active.
+func (j JobSpec) Active() bool {
+	return j.Status == JobSpecStatusActive || j.Status == ""
+}
+
 // NewRun initializes the job by creating the IDs for the job
 // and all associated tasks, and setting the CreatedAt field.
 func (j JobSpec) NewRun(i Initiator) JobRun {
@@ -82,14 +163,15 @@ func (j JobSpec) NewRun(i Initiator) JobRun {
 
 	now := time.Now()
 	return JobRun{
-		ID:        jrid,
-		JobID:     j.ID,
-		CreatedAt: now,
-		UpdatedAt: now,
-		TaskRuns:  taskRuns,
-		Initiator: i,
-		Status:    RunStatusUnstarted,
-		Result:    RunResult{JobRunID: jrid},
+		ID:         jrid,
+		JobID:      j.ID,
+		JobVersion: j.Version,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+		TaskRuns:   taskRuns,
+		Initiator:  i,
+		Status:     RunStatusUnstarted,
+		Result:     RunResult{JobRunID: jrid},
 	}
 }
 
@@ -127,6 +209,23 @@ func (j JobSpec) IsLogInitiated() bool {
 	return false
 }
 
+// IsPendingTxInitiated returns true if any of the job's initiators are
+// triggered by pending transactions.
+func (j JobSpec) IsPendingTxInitiated() bool {
+	return len(j.InitiatorsFor(InitiatorPendingTransaction)) > 0
+}
+
+// IsGraph returns true if any of the job's tasks depend on other tasks,
+// making its tasks a graph rather than a linear pipeline.
+func (j JobSpec) IsGraph() bool {
+	for _, task := range j.Tasks {
+		if len(task.DependsOn) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
 // Ended returns true if the job has ended.
 func (j JobSpec) Ended(t time.Time) bool {
 	if !j.EndAt.Valid {
@@ -159,6 +258,34 @@ const (
 	// InitiatorServiceAgreementExecutionLog for tasks in a job to watch a
 	// Solidity Coordinator contract and expect a payload from a log event.
 	InitiatorServiceAgreementExecutionLog = "execagreement"
+	// InitiatorRunCompletion for tasks in a job to be ran when a run of
+	// another job finishes.
+	InitiatorRunCompletion = "runcompletion"
+	// InitiatorPendingTransaction for tasks in a job to be ran when a
+	// matching transaction enters the ethereum node's transaction pool.
+	InitiatorPendingTransaction = "pendingtx"
+)
+
+// Overlap policies of the cron initiator, deciding what happens when a run is
+// scheduled while a previous run of the job has not yet finished.
+const (
+	// OverlapPolicyAllow starts the new run alongside the unfinished one.
+	OverlapPolicyAllow = "allow"
+	// OverlapPolicySkip drops the new run.
+	OverlapPolicySkip = "skip"
+	// OverlapPolicyQueue waits for the unfinished run before starting the new one.
+	OverlapPolicyQueue = "queue"
+)
+
+// Misfire policies of the cron and runat initiators, deciding what happens to
+// scheduled runs that were missed while the node was down.
+const (
+	// MisfirePolicyFireOnce starts a single run for any number of missed runs.
+	MisfirePolicyFireOnce = "fireOnce"
+	// MisfirePolicyFireAll starts every missed run, up to the MisfireLimit.
+	MisfirePolicyFireAll = "fireAll"
+	// MisfirePolicySkip drops the missed runs.
+	MisfirePolicySkip = "skip"
 )
 
 // Initiator could be thought of as a trigger, defines how a Job can be
@@ -181,6 +308,29 @@ type InitiatorParams struct {
 	Ran        bool             `json:"ran,omitempty"`
 	Address    common.Address   `json:"address,omitempty" storm:"index"`
 	Requesters []common.Address `json:"requesters,omitempty"`
+	// Timezone is the IANA time zone the cron Schedule is evaluated in,
+	// defaulting to the node's local time. Jitter delays each scheduled run
+	// by a random duration up to its value, and OverlapPolicy is one of the
+	// OverlapPolicy* constants, defaulting to OverlapPolicyAllow.
+	Timezone      string   `json:"timezone,omitempty"`
+	Jitter        Duration `json:"jitter,omitempty"`
+	OverlapPolicy string   `json:"overlapPolicy,omitempty"`
+	// MisfirePolicy is one of the MisfirePolicy* constants, applied on start
+	// to the cron and runat runs missed since LastFiredAt. Cron initiators
+	// default to MisfirePolicySkip and runat initiators to
+	// MisfirePolicyFireOnce.
+	MisfirePolicy string    `json:"misfirePolicy,omitempty"`
+	MisfireLimit  uint      `json:"misfireLimit,omitempty"`
+	LastFiredAt   null.Time `json:"lastFiredAt,omitempty"`
+	// ParentJobID and ParentStatuses are used by the run completion initiator
+	// to select the finished runs that trigger it.
+	ParentJobID    string      `json:"parentJobId,omitempty" storm:"index"`
+	ParentStatuses []RunStatus `json:"parentStatuses,omitempty"`
+	// ToAddresses, FromAddresses and FunctionSelector are used by the pending
+	// transaction initiator to select the transactions that trigger it.
+	ToAddresses      []common.Address  `json:"toAddresses,omitempty"`
+	FromAddresses    []common.Address  `json:"fromAddresses,omitempty"`
+	FunctionSelector *FunctionSelector `json:"functionSelector,omitempty"`
 }
 
 // UnmarshalJSON parses the raw initiator data and updates the
@@ -203,13 +353,132 @@ func (i Initiator) IsLogInitiated() bool {
 		i.Type == InitiatorServiceAgreementExecutionLog
 }
 
+// EffectiveMisfirePolicy returns the initiator's MisfirePolicy, or the default
+// policy for its type when none is set.
+func (i Initiator) EffectiveMisfirePolicy() string {
+	if i.MisfirePolicy != "" {
+		return i.MisfirePolicy
+	}
+	if i.Type == InitiatorCron {
+		return MisfirePolicySkip
+	}
+	return MisfirePolicyFireOnce
+}
+
+// TriggeredByRunStatus returns true if a parent run finishing with the given
+// status should start a run of this initiator's job. Run completion initiators
+// without any ParentStatuses are only triggered by completed runs.
+func (i Initiator) TriggeredByRunStatus(status RunStatus) bool {
+	if i.Type != InitiatorRunCompletion {
+		return false
+	}
+	if len(i.ParentStatuses) == 0 {
+		return status.Completed()
+	}
+	for _, s := range i.ParentStatuses {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
+
+// MatchesPendingTx returns true if the pending transaction is sent to one of
+// the initiator's ToAddresses or from one of its FromAddresses, and calls its
+// FunctionSelector when one is set.
+func (i Initiator) MatchesPendingTx(tx PendingTransaction) bool {
+	if i.Type != InitiatorPendingTransaction {
+		return false
+	}
+	if i.FunctionSelector != nil && !bytes.HasPrefix(tx.Input, i.FunctionSelector.Bytes()) {
+		return false
+	}
+	if tx.To != nil && containsAddress(i.ToAddresses, *tx.To) {
+		return true
+	}
+	return containsAddress(i.FromAddresses, tx.From)
+}
+
+func containsAddress(addresses []common.Address, address common.Address) bool {
+	for _, a := range addresses {
+		if a == address {
+			return true
+		}
+	}
+	return false
+}
+
 // TaskSpec is the definition of work to be carried out. The
 // Type will be an adapter, and the Params will contain any
 // additional information that adapter would need to operate.
+//
+// Tasks are run one after another in order unless any task of the job
+// declares DependsOn, the IDs of the tasks whose output it takes as input.
+// Tasks of such a task graph run as soon as all the tasks they depend on
+// have completed, with independent tasks running concurrently.
+//
+// A task that does not finish within its Timeout errors, and an errored task
+// is attempted again up to Retries times, after RetryBackoff doubled for
+// every attempt made, as long as its error matches one of RetryOn. Tasks
+// sending transactions take neither, since a timed out attempt is not
+// stopped and could still send its transaction.
 type TaskSpec struct {
+	ID            string   `json:"id,omitempty"`
 	Type          TaskType `json:"type" storm:"index"`
 	Confirmations uint64   `json:"confirmations"`
 	Params        JSON     `json:"params"`
+	DependsOn     []string `json:"dependsOn,omitempty"`
+	Timeout       Duration `json:"timeout,omitempty"`
+	Retries       uint     `json:"retries,omitempty"`
+	RetryBackoff  Duration `json:"retryBackoff,omitempty"`
+	RetryOn       []string `json:"retryOn,omitempty"`
+}
+
+// TaskErrorClassTimeout is the RetryOn error class of tasks that did not
+// finish within their Timeout. Any other RetryOn entry matches errors whose
+// message contains it.
+const TaskErrorClassTimeout = "timeout"
+
+// TaskTimeoutError is the error of a task that did not finish within its
+// Timeout.
+type TaskTimeoutError struct {
+	Timeout time.Duration
+}
+
+// Error returns the error message of the timeout.
+func (err TaskTimeoutError) Error() string {
+	return fmt.Sprintf("task timed out after %v", err.Timeout)
+}
+
+// Retryable returns true if the task should be attempted again after the
+// passed number of attempts, the last of which errored with the given
+// message. All errors are retryable when RetryOn is empty.
+func (t TaskSpec) Retryable(attempts int, errorMessage string) bool {
+	if attempts > int(t.Retries) {
+		return false
+	}
+	if len(t.RetryOn) == 0 {
+		return true
+	}
+	timeoutMessage := TaskTimeoutError{Timeout: t.Timeout.Duration()}.Error()
+	for _, class := range t.RetryOn {
+		if class == TaskErrorClassTimeout && strings.Contains(errorMessage, timeoutMessage) {
+			return true
+		} else if class != TaskErrorClassTimeout && strings.Contains(errorMessage, class) {
+			return true
+		}
+	}
+	return false
+}
+
+// RetryDelay returns how long to wait before attempting the task again after
+// the passed number of attempts.
+func (t TaskSpec) RetryDelay(attempts int) time.Duration {
+	delay := t.RetryBackoff.Duration()
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+	}
+	return delay
 }
 
 // TaskType defines what Adapter a TaskSpec will use.
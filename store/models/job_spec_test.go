@@ -4,6 +4,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/smartcontractkit/chainlink/adapters"
 	"github.com/smartcontractkit/chainlink/internal/cltest"
 	"github.com/smartcontractkit/chainlink/store/models"
@@ -46,6 +48,31 @@ func TestJobSpec_NewRun(t *testing.T) {
 	assert.Equal(t, initr, run.Initiator)
 }
 
+func TestJobSpec_Update(t *testing.T) {
+	t.Parallel()
+
+	job, _ := cltest.NewJobWithSchedule("1 * * * *")
+	job.Initiators[0].ID = 3
+	now := time.Now()
+
+	next := job.Update(models.JobSpecRequest{
+		Initiators: []models.Initiator{{Type: models.InitiatorWeb}},
+		Tasks:      []models.TaskSpec{cltest.NewTask("NoOp"), cltest.NewTask("NoOp")},
+	}, now)
+
+	assert.Equal(t, job.ID, next.ID)
+	assert.Equal(t, job.CreatedAt, next.CreatedAt)
+	assert.Equal(t, now, next.UpdatedAt.Time)
+	assert.Equal(t, job.Version+1, next.Version)
+	assert.Equal(t, job.ID, next.Initiators[0].JobID)
+	assert.Equal(t, 0, next.Initiators[0].ID)
+	assert.Len(t, next.Tasks, 2)
+	assert.Equal(t, models.InitiatorCron, job.Initiators[0].Type, "should leave the previous version untouched")
+
+	run := next.NewRun(next.Initiators[0])
+	assert.Equal(t, next.Version, run.JobVersion)
+}
+
 func TestJobEnded(t *testing.T) {
 	t.Parallel()
 
@@ -154,3 +181,108 @@ func TestBridgeType_Authenticate(t *testing.T) {
 		})
 	}
 }
+
+func TestInitiator_TriggeredByRunStatus(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		initr    models.Initiator
+		status   models.RunStatus
+		expected bool
+	}{
+		{"default completed", models.Initiator{Type: models.InitiatorRunCompletion}, models.RunStatusCompleted, true},
+		{"default errored", models.Initiator{Type: models.InitiatorRunCompletion}, models.RunStatusErrored, false},
+		{"errored only", models.Initiator{
+			Type:            models.InitiatorRunCompletion,
+			InitiatorParams: models.InitiatorParams{ParentStatuses: []models.RunStatus{models.RunStatusErrored}},
+		}, models.RunStatusErrored, true},
+		{"errored only completed", models.Initiator{
+			Type:            models.InitiatorRunCompletion,
+			InitiatorParams: models.InitiatorParams{ParentStatuses: []models.RunStatus{models.RunStatusErrored}},
+		}, models.RunStatusCompleted, false},
+		{"other initiator", models.Initiator{Type: models.InitiatorWeb}, models.RunStatusCompleted, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, test.initr.TriggeredByRunStatus(test.status))
+		})
+	}
+}
+
+func TestInitiator_MatchesPendingTx(t *testing.T) {
+	t.Parallel()
+
+	oracle := cltest.NewAddress()
+	sender := cltest.NewAddress()
+	other := cltest.NewAddress()
+	selector := models.HexToFunctionSelector("0x70a08231")
+
+	tests := []struct {
+		name     string
+		params   models.InitiatorParams
+		tx       models.PendingTransaction
+		expected bool
+	}{
+		{"to address", models.InitiatorParams{ToAddresses: []common.Address{oracle}},
+			models.PendingTransaction{From: other, To: &oracle}, true},
+		{"from address", models.InitiatorParams{FromAddresses: []common.Address{sender}},
+			models.PendingTransaction{From: sender, To: &other}, true},
+		{"contract creation", models.InitiatorParams{ToAddresses: []common.Address{oracle}},
+			models.PendingTransaction{From: sender}, false},
+		{"no match", models.InitiatorParams{ToAddresses: []common.Address{oracle}, FromAddresses: []common.Address{sender}},
+			models.PendingTransaction{From: other, To: &other}, false},
+		{"selector match", models.InitiatorParams{ToAddresses: []common.Address{oracle}, FunctionSelector: &selector},
+			models.PendingTransaction{From: other, To: &oracle, Input: hexutil.MustDecode("0x70a08231deadbeef")}, true},
+		{"selector mismatch", models.InitiatorParams{ToAddresses: []common.Address{oracle}, FunctionSelector: &selector},
+			models.PendingTransaction{From: other, To: &oracle, Input: hexutil.MustDecode("0xdeadbeef")}, false},
+		{"selector without input", models.InitiatorParams{ToAddresses: []common.Address{oracle}, FunctionSelector: &selector},
+			models.PendingTransaction{From: other, To: &oracle}, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			initr := models.Initiator{Type: models.InitiatorPendingTransaction, InitiatorParams: test.params}
+			assert.Equal(t, test.expected, initr.MatchesPendingTx(test.tx))
+		})
+	}
+}
+
+func TestTaskSpec_Retryable(t *testing.T) {
+	t.Parallel()
+
+	timeout := models.Duration(time.Second)
+	timeoutMessage := models.TaskTimeoutError{Timeout: time.Second}.Error()
+
+	tests := []struct {
+		name     string
+		task     models.TaskSpec
+		attempts int
+		message  string
+		expected bool
+	}{
+		{"no retries", models.TaskSpec{}, 1, "boom", false},
+		{"any error", models.TaskSpec{Retries: 1}, 1, "boom", true},
+		{"retries exhausted", models.TaskSpec{Retries: 1}, 2, "boom", false},
+		{"matching error", models.TaskSpec{Retries: 1, RetryOn: []string{"503"}}, 1, "POST response: 503", true},
+		{"other error", models.TaskSpec{Retries: 1, RetryOn: []string{"503"}}, 1, "POST response: 400", false},
+		{"timeout", models.TaskSpec{Retries: 1, Timeout: timeout, RetryOn: []string{"timeout"}}, 1, timeoutMessage, true},
+		{"not a timeout", models.TaskSpec{Retries: 1, Timeout: timeout, RetryOn: []string{"timeout"}}, 1, "boom", false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, test.task.Retryable(test.attempts, test.message))
+		})
+	}
+}
+
+func TestTaskSpec_RetryDelay(t *testing.T) {
+	t.Parallel()
+
+	task := models.TaskSpec{RetryBackoff: models.Duration(time.Second)}
+	assert.Equal(t, time.Second, task.RetryDelay(1))
+	assert.Equal(t, 2*time.Second, task.RetryDelay(2))
+	assert.Equal(t, 4*time.Second, task.RetryDelay(3))
+}
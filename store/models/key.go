@@ -0,0 +1,28 @@
+package models
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Key holds the node's settings for an account in its keystore.
+type Key struct {
+	Address      common.Address `json:"address" storm:"id,unique"`
+	SendDisabled bool           `json:"sendDisabled"`
+}
+
+// GetID returns the ID of this structure for jsonapi serialization.
+func (k Key) GetID() string {
+	return k.Address.Hex()
+}
+
+// GetName returns the pluralized "type" of this structure for jsonapi serialization.
+func (k Key) GetName() string {
+	return "keys"
+}
+
+// SetID is used to conform to the UnmarshallIdentifier interface for
+// deserializing from jsonapi documents.
+func (k *Key) SetID(value string) error {
+	k.Address = common.HexToAddress(value)
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/big"
+	"time"
 
 	ethereum "github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
@@ -12,6 +13,7 @@ import (
 	"github.com/smartcontractkit/chainlink/logger"
 	"github.com/smartcontractkit/chainlink/store/assets"
 	"github.com/smartcontractkit/chainlink/utils"
+	null "gopkg.in/guregu/null.v3"
 )
 
 // Descriptive indices of a RunLog's Topic array
@@ -119,10 +121,20 @@ type LogRequest interface {
 	ToDebug()
 	ForLogger(kvs ...interface{}) []interface{}
 	ContractPayment() (*assets.Link, error)
+	RequestID() common.Hash
+	RequestExpiration() null.Time
 	ValidateRequester() error
 	ToIndexableBlockNumber() *IndexableBlockNumber
 }
 
+// LogKey returns the key of the run of a job initiated by the log request. The
+// key stays the same when the log is delivered again, whether after a
+// reconnect or in another block after a reorg.
+func LogKey(le LogRequest) string {
+	log := le.GetLog()
+	return fmt.Sprintf("%s-%s-%d-%s", le.GetJobSpec().ID, log.TxHash.Hex(), log.Index, le.RequestID().Hex())
+}
+
 // InitiatorLogEvent encapsulates all information as a result of a received log from an
 // InitiatorSubscription.
 type InitiatorLogEvent struct {
@@ -219,6 +231,18 @@ func (le InitiatorLogEvent) ContractPayment() (*assets.Link, error) {
 	return nil, nil
 }
 
+// RequestID returns an empty hash, since base initiator log events are not
+// requests.
+func (le InitiatorLogEvent) RequestID() common.Hash {
+	return common.Hash{}
+}
+
+// RequestExpiration returns an invalid time, since base initiator log events
+// do not expire.
+func (le InitiatorLogEvent) RequestExpiration() null.Time {
+	return null.Time{}
+}
+
 // EthLogEvent provides functionality specific to a log event emitted
 // for an eth log initiator.
 type EthLogEvent struct {
@@ -254,6 +278,36 @@ func (le RunLogEvent) ContractPayment() (*assets.Link, error) {
 	return payment, nil
 }
 
+// RequestID returns the ID assigned to the request by the contract emitting
+// the log.
+func (le RunLogEvent) RequestID() common.Hash {
+	if len(le.Log.Data) < idSize {
+		return common.Hash{}
+	}
+	return common.BytesToHash(le.Log.Data[:idSize])
+}
+
+// maxRequestExpiration is the latest request expiration, in seconds since the
+// epoch, that can be represented as a time; the end of the year 9999.
+var maxRequestExpiration = big.NewInt(253402300799)
+
+// RequestExpiration returns the time after which the request can no longer be
+// fulfilled on chain, or an invalid time for logs without an expiration.
+// Expirations too far in the future to represent are treated as none.
+func (le RunLogEvent) RequestExpiration() null.Time {
+	el := le.Log
+	start := idSize + versionSize + callbackAddrSize + callbackFuncSize
+	if len(el.Topics) == 0 || el.Topics[0] != RunLogTopic20190123 || len(el.Data) < start+expirationSize {
+		return null.Time{}
+	}
+
+	expiration := new(big.Int).SetBytes(el.Data[start : start+expirationSize])
+	if expiration.Cmp(maxRequestExpiration) > 0 {
+		return null.Time{}
+	}
+	return null.TimeFrom(time.Unix(expiration.Int64(), 0))
+}
+
 // ValidateRequester returns true if the requester matches the one associated
 // with the initiator.
 func (le RunLogEvent) ValidateRequester() error {
@@ -1,9 +1,11 @@
 package models_test
 
 import (
+	"fmt"
 	"math/big"
 	"strings"
 	"testing"
+	"time"
 
 	ethereum "github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
@@ -13,6 +15,7 @@ import (
 	"github.com/smartcontractkit/chainlink/store/models"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	null "gopkg.in/guregu/null.v3"
 )
 
 func TestParseRunLog(t *testing.T) {
@@ -58,6 +61,67 @@ func TestParseRunLog(t *testing.T) {
 	}
 }
 
+func TestRunLogEvent_RequestExpiration(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		log  models.Log
+		want null.Time
+	}{
+		{
+			"without expiration",
+			cltest.LogFromFixture("../../internal/fixtures/eth/subscription_logs_hello_world.json"),
+			null.Time{},
+		},
+		{
+			"with expiration",
+			cltest.LogFromFixture("../../internal/fixtures/eth/request_log20190123.json"),
+			null.TimeFrom(time.Unix(0x5c4a7338, 0)),
+		},
+		{
+			"with expiration beyond the year 9999",
+			logWithExpiration(new(big.Int).Lsh(big.NewInt(1), 64)),
+			null.Time{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			le := models.RunLogEvent{InitiatorLogEvent: models.InitiatorLogEvent{Log: test.log}}
+			assert.Equal(t, test.want, le.RequestExpiration())
+		})
+	}
+}
+
+func logWithExpiration(expiration *big.Int) models.Log {
+	log := cltest.LogFromFixture("../../internal/fixtures/eth/request_log20190123.json")
+	log.Data = append([]byte{}, log.Data...)
+	copy(log.Data[4*common.HashLength:5*common.HashLength], common.BigToHash(expiration).Bytes())
+	return log
+}
+
+func TestLogKey(t *testing.T) {
+	t.Parallel()
+
+	log := cltest.LogFromFixture("../../internal/fixtures/eth/request_log20190123.json")
+	job := cltest.NewJob()
+	le := models.RunLogEvent{InitiatorLogEvent: models.InitiatorLogEvent{JobSpec: job, Log: log}}
+
+	requestID := "0xc524fafafcaec40652b1f84fca09c231185437d008d195fccf2f51e64b7062f8"
+	assert.Equal(t, requestID, le.RequestID().Hex())
+	assert.Equal(t, fmt.Sprintf("%s-%s-%d-%s", job.ID, log.TxHash.Hex(), log.Index, requestID), models.LogKey(le))
+
+	reorged := le
+	reorged.Log.BlockHash = cltest.NewHash()
+	reorged.Log.BlockNumber++
+	assert.Equal(t, models.LogKey(le), models.LogKey(reorged))
+
+	other := le
+	other.Log.Index++
+	assert.NotEqual(t, models.LogKey(le), models.LogKey(other))
+}
+
 func TestEthLogEvent_JSON(t *testing.T) {
 	t.Parallel()
 
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/smartcontractkit/chainlink/store/assets"
 	"github.com/tidwall/gjson"
@@ -16,6 +17,7 @@ import (
 type JobRun struct {
 	ID             string       `json:"id" storm:"id,unique"`
 	JobID          string       `json:"jobId" storm:"index"`
+	JobVersion     uint64       `json:"jobVersion"`
 	Result         RunResult    `json:"result" storm:"inline"`
 	Status         RunStatus    `json:"status" storm:"index"`
 	TaskRuns       []TaskRun    `json:"taskRuns" storm:"inline"`
@@ -26,6 +28,27 @@ type JobRun struct {
 	CreationHeight *hexutil.Big `json:"creationHeight"`
 	ObservedHeight *hexutil.Big `json:"observedHeight"`
 	Overrides      RunResult    `json:"overrides"`
+	TxHash         *common.Hash `json:"txHash,omitempty"`
+	LogKey         string       `json:"logKey,omitempty" storm:"index"`
+	LogRemoved     bool         `json:"logRemoved,omitempty"`
+	ExpiresAt      null.Time    `json:"expiresAt"`
+	ParentRunID    string       `json:"parentRunId,omitempty" storm:"index"`
+	ChildRunIDs    []string     `json:"childRunIds,omitempty"`
+	// ChildrenTriggeredFor holds the statuses the run has finished with and
+	// started the runs of its chained jobs for, so that a run resumed after
+	// erroring still starts the jobs chained to its completion.
+	ChildrenTriggeredFor []RunStatus `json:"childrenTriggeredFor,omitempty"`
+	// OrphanedAt is when the run's triggering log was found to have been
+	// orphaned by a chain reorganisation. It is cleared once the log's
+	// transaction is included in the new chain.
+	OrphanedAt null.Time `json:"orphanedAt"`
+	Notes      []RunNote `json:"notes,omitempty"`
+}
+
+// RunNote records an action taken on a run by the node operator.
+type RunNote struct {
+	CreatedAt time.Time `json:"createdAt"`
+	Text      string    `json:"text"`
 }
 
 // GetID returns the ID of this structure for jsonapi serialization.
@@ -63,10 +86,17 @@ func (jr JobRun) ForLogger(kvs ...interface{}) []interface{} {
 	return append(kvs, output...)
 }
 
-// NextTaskRunIndex returns the position of the next unfinished task
+// NextTaskRunIndex returns the position of the next unfinished task, giving
+// precedence to a pending task so that a blocked branch of a task graph is
+// the one resumed.
 func (jr JobRun) NextTaskRunIndex() (int, bool) {
 	for index, tr := range jr.TaskRuns {
-		if !(tr.Status.Completed() || tr.Status.Errored()) {
+		if tr.Status.Pending() {
+			return index, true
+		}
+	}
+	for index, tr := range jr.TaskRuns {
+		if !tr.Status.Finished() {
 			return index, true
 		}
 	}
@@ -92,6 +122,94 @@ func (jr JobRun) PreviousTaskRun() *TaskRun {
 	return nil
 }
 
+// IsGraph returns true if the run's tasks declare dependencies on each other,
+// rather than being run one after another in order.
+func (jr JobRun) IsGraph() bool {
+	for _, tr := range jr.TaskRuns {
+		if len(tr.Task.DependsOn) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// ParentTaskRuns returns the task runs the passed task run depends on, in the
+// order they are declared in its task's DependsOn.
+func (jr JobRun) ParentTaskRuns(taskRun TaskRun) []TaskRun {
+	parents := []TaskRun{}
+	for _, id := range taskRun.Task.DependsOn {
+		for _, tr := range jr.TaskRuns {
+			if tr.Task.ID == id {
+				parents = append(parents, tr)
+			}
+		}
+	}
+	return parents
+}
+
+// ReadyTaskRunIndexes returns the positions of the unfinished and unblocked
+// task runs of a task graph whose parents have all completed, which can
+// therefore be executed concurrently.
+func (jr JobRun) ReadyTaskRunIndexes() []int {
+	indexes := []int{}
+	for index, tr := range jr.TaskRuns {
+		if tr.Status.Finished() || !tr.Status.Runnable() {
+			continue
+		}
+		ready := true
+		for _, parent := range jr.ParentTaskRuns(tr) {
+			if !parent.Status.Completed() {
+				ready = false
+			}
+		}
+		if ready {
+			indexes = append(indexes, index)
+		}
+	}
+	return indexes
+}
+
+// BridgeTaskRunIndex returns the position of the task run awaiting the
+// external adapter response identified by taskRunID. Responses to runs
+// started before task run IDs were included in the response URL carry no
+// ID, and are accepted as long as a single task run is pending.
+func (jr JobRun) BridgeTaskRunIndex(taskRunID string) (int, error) {
+	if taskRunID != "" {
+		for index, tr := range jr.TaskRuns {
+			if tr.ID != taskRunID {
+				continue
+			}
+			if !tr.Status.PendingBridge() {
+				return 0, fmt.Errorf("Attempting to resume non pending task run %s", taskRunID)
+			}
+			return index, nil
+		}
+		return 0, fmt.Errorf("Task run %s not found in run %s", taskRunID, jr.ID)
+	}
+
+	if !jr.Status.PendingBridge() {
+		return 0, fmt.Errorf("Attempting to resume non pending run %s", jr.ID)
+	}
+	pending := []int{}
+	for index, tr := range jr.TaskRuns {
+		if tr.Status.PendingBridge() {
+			pending = append(pending, index)
+		}
+	}
+	switch len(pending) {
+	case 0:
+		index, ok := jr.NextTaskRunIndex()
+		if !ok {
+			return 0, fmt.Errorf("Attempting to resume pending run with no remaining tasks %s", jr.ID)
+		}
+		return index, nil
+	case 1:
+		return pending[0], nil
+	default:
+		return 0, fmt.Errorf("Run %s has several task runs pending, the task run to resume must be given", jr.ID)
+	}
+}
+
 // TasksRemain returns true if there are unfinished tasks left for this job run
 func (jr JobRun) TasksRemain() bool {
 	_, runnable := jr.NextTaskRunIndex()
@@ -108,6 +226,60 @@ func (jr JobRun) ApplyResult(result RunResult) JobRun {
 	return jr
 }
 
+// Orphaned returns true if the run's triggering log was orphaned by a chain
+// reorganisation and has not been included in the new chain yet.
+func (jr JobRun) Orphaned() bool {
+	return jr.OrphanedAt.Valid
+}
+
+// RequestExpired returns true if the run's request can no longer be
+// fulfilled on chain at the passed time.
+func (jr JobRun) RequestExpired(now time.Time) bool {
+	return jr.ExpiresAt.Valid && !now.Before(jr.ExpiresAt.Time)
+}
+
+// RequestExpiredError is the error of a run that reached a task writing to
+// the block chain after its request expired.
+type RequestExpiredError struct {
+	ExpiresAt time.Time
+}
+
+// Error returns the error message of the expiration.
+func (err RequestExpiredError) Error() string {
+	return fmt.Sprintf("request expired at %v", err.ExpiresAt)
+}
+
+// ChildrenTriggered returns true if the runs of the jobs chained to this
+// run's job have been started for the given status.
+func (jr JobRun) ChildrenTriggered(status RunStatus) bool {
+	for _, s := range jr.ChildrenTriggeredFor {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
+
+// AddNote appends a note to the run.
+func (jr *JobRun) AddNote(createdAt time.Time, text string) {
+	jr.Notes = append(jr.Notes, RunNote{CreatedAt: createdAt, Text: text})
+}
+
+// Cancel marks the run and its unfinished task runs as cancelled.
+func (jr JobRun) Cancel() JobRun {
+	for i, tr := range jr.TaskRuns {
+		if !tr.Status.Finished() {
+			tr.RetryAt = null.Time{}
+			tr.Status = RunStatusCancelled
+			tr.Result.Status = RunStatusCancelled
+			jr.TaskRuns[i] = tr
+		}
+	}
+	jr.Status = RunStatusCancelled
+	jr.Result.Status = RunStatusCancelled
+	return jr
+}
+
 // MarkCompleted sets the JobRun's status to completed and records the
 // completed at time.
 func (jr JobRun) MarkCompleted() JobRun {
@@ -120,11 +292,22 @@ func (jr JobRun) MarkCompleted() JobRun {
 // TaskRun stores the Task and represents the status of the
 // Task to be ran.
 type TaskRun struct {
-	ID                   string    `json:"id" storm:"id,unique"`
-	Result               RunResult `json:"result"`
-	Status               RunStatus `json:"status"`
-	Task                 TaskSpec  `json:"task"`
-	MinimumConfirmations uint64    `json:"minimumConfirmations"`
+	ID                   string        `json:"id" storm:"id,unique"`
+	Result               RunResult     `json:"result"`
+	Status               RunStatus     `json:"status"`
+	Task                 TaskSpec      `json:"task"`
+	MinimumConfirmations uint64        `json:"minimumConfirmations"`
+	Attempts             []TaskAttempt `json:"attempts,omitempty"`
+	RetriesFrom          int           `json:"retriesFrom,omitempty"`
+	RetryAt              null.Time     `json:"retryAt"`
+}
+
+// TaskAttempt records the outcome of a single execution of a task.
+type TaskAttempt struct {
+	StartedAt    time.Time   `json:"startedAt"`
+	FinishedAt   time.Time   `json:"finishedAt"`
+	Status       RunStatus   `json:"status"`
+	ErrorMessage null.String `json:"error"`
 }
 
 // String returns info on the TaskRun as "ID,Type,Status,Result".
@@ -155,6 +338,51 @@ func (tr TaskRun) ApplyResult(result RunResult) TaskRun {
 	return tr
 }
 
+// ApplyAttempt applies the result of an execution of the task that started
+// at the passed time, recording it as an attempt once it has finished. An
+// errored result that the task's retry policy allows to be retried instead
+// leaves the TaskRun pending_retry until RetryAt. Only the attempts from
+// RetriesFrom on count against the retry policy.
+func (tr TaskRun) ApplyAttempt(result RunResult, startedAt time.Time, finishedAt time.Time) TaskRun {
+	if !result.Status.Finished() {
+		return tr.ApplyResult(result)
+	}
+
+	tr.Attempts = append(tr.Attempts, TaskAttempt{
+		StartedAt:    startedAt,
+		FinishedAt:   finishedAt,
+		Status:       result.Status,
+		ErrorMessage: result.ErrorMessage,
+	})
+
+	attempts := len(tr.Attempts) - tr.RetriesFrom
+	if !result.Status.Errored() || !tr.Task.Retryable(attempts, result.Error()) {
+		tr.RetryAt = null.Time{}
+		return tr.ApplyResult(result)
+	}
+
+	tr.RetryAt = null.TimeFrom(finishedAt.Add(tr.Task.RetryDelay(attempts)))
+	tr.Result = RunResult{JobRunID: tr.Result.JobRunID, Status: RunStatusPendingRetry}
+	tr.Status = RunStatusPendingRetry
+	return tr
+}
+
+// MarkResumed readies an errored TaskRun to be executed again with all of
+// its retries, keeping the attempts made so far.
+func (tr TaskRun) MarkResumed() TaskRun {
+	tr = tr.MarkRetrying()
+	tr.RetriesFrom = len(tr.Attempts)
+	return tr
+}
+
+// MarkRetrying readies a pending_retry TaskRun to be executed again.
+func (tr TaskRun) MarkRetrying() TaskRun {
+	tr.RetryAt = null.Time{}
+	tr.Result = RunResult{JobRunID: tr.Result.JobRunID}
+	tr.Status = RunStatusUnstarted
+	return tr
+}
+
 // MarkCompleted marks the task's status as completed.
 func (tr TaskRun) MarkCompleted() TaskRun {
 	tr.Status = RunStatusCompleted
@@ -173,6 +401,7 @@ func (tr TaskRun) MarkPendingConfirmations() TaskRun {
 // Data and ErrorMessage, and contains a field to track the status.
 type RunResult struct {
 	JobRunID     string       `json:"jobRunId"`
+	TaskRunID    string       `json:"taskRunId,omitempty"`
 	Data         JSON         `json:"data"`
 	Status       RunStatus    `json:"status"`
 	ErrorMessage null.String  `json:"error"`
@@ -215,6 +444,22 @@ func (rr RunResult) WithError(err error) RunResult {
 	return rr
 }
 
+// MarkExpired returns a copy of RunResult but with status set to expired and
+// the error message set to the passed error.
+func (rr RunResult) MarkExpired(err error) RunResult {
+	rr.ErrorMessage = null.StringFrom(err.Error())
+	rr.Status = RunStatusExpired
+	return rr
+}
+
+// MarkCancelled returns a copy of RunResult but with status set to cancelled
+// and the error message set to the passed error.
+func (rr RunResult) MarkCancelled(err error) RunResult {
+	rr.ErrorMessage = null.StringFrom(err.Error())
+	rr.Status = RunStatusCancelled
+	return rr
+}
+
 // MarkPendingBridge returns a copy of RunResult but with status set to pending_bridge.
 func (rr RunResult) MarkPendingBridge() RunResult {
 	rr.Status = RunStatusPendingBridge
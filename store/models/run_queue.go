@@ -0,0 +1,87 @@
+package models
+
+import (
+	"time"
+
+	"github.com/smartcontractkit/chainlink/store/assets"
+	null "gopkg.in/guregu/null.v3"
+)
+
+// QueuedRun is a run waiting for a free worker of the job runner. It is
+// persisted so that the run keeps its place in the queue across restarts.
+type QueuedRun struct {
+	RunID     string       `json:"runId" storm:"id,unique"`
+	JobID     string       `json:"jobId" storm:"index"`
+	Payment   *assets.Link `json:"payment"`
+	ExpiresAt null.Time    `json:"expiresAt"`
+	QueuedAt  time.Time    `json:"queuedAt"`
+}
+
+// NewQueuedRun returns the queue entry of the run, queued at the passed time.
+func NewQueuedRun(run JobRun, now time.Time) QueuedRun {
+	return QueuedRun{
+		RunID:     run.ID,
+		JobID:     run.JobID,
+		Payment:   run.Overrides.Amount,
+		ExpiresAt: run.ExpiresAt,
+		QueuedAt:  now,
+	}
+}
+
+// Before returns true if the queued run should be executed before the other.
+// Runs paying more go first, then runs that expire sooner, then the runs
+// that have waited the longest.
+func (qr QueuedRun) Before(other QueuedRun) bool {
+	if cmp := qr.payment().Cmp(other.payment()); cmp != 0 {
+		return cmp > 0
+	}
+	if qr.ExpiresAt.Valid != other.ExpiresAt.Valid {
+		return qr.ExpiresAt.Valid
+	}
+	if qr.ExpiresAt.Valid && !qr.ExpiresAt.Time.Equal(other.ExpiresAt.Time) {
+		return qr.ExpiresAt.Time.Before(other.ExpiresAt.Time)
+	}
+	return qr.QueuedAt.Before(other.QueuedAt)
+}
+
+func (qr QueuedRun) payment() *assets.Link {
+	if qr.Payment == nil {
+		return assets.NewLink(0)
+	}
+	return qr.Payment
+}
+
+// RunQueueStats describes the runs executing and waiting in the job runner's
+// queue.
+type RunQueueStats struct {
+	MaxConcurrentRuns uint64          `json:"maxConcurrentRuns"`
+	Executing         int             `json:"executing"`
+	Depth             int             `json:"depth"`
+	OldestWait        Duration        `json:"oldestWait"`
+	AverageWait       Duration        `json:"averageWait"`
+	Jobs              []JobQueueStats `json:"jobs"`
+}
+
+// JobQueueStats describes the runs of a job executing and waiting in the job
+// runner's queue.
+type JobQueueStats struct {
+	JobID     string `json:"jobId"`
+	Executing int    `json:"executing"`
+	Depth     int    `json:"depth"`
+}
+
+// GetID returns the ID of this structure for jsonapi serialization.
+func (rqs RunQueueStats) GetID() string {
+	return "run_queue"
+}
+
+// GetName returns the pluralized "type" of this structure for jsonapi serialization.
+func (rqs RunQueueStats) GetName() string {
+	return "run_queues"
+}
+
+// SetID is used to conform to the UnmarshallIdentifier interface for
+// deserializing from jsonapi documents.
+func (rqs *RunQueueStats) SetID(value string) error {
+	return nil
+}
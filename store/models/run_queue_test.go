@@ -0,0 +1,74 @@
+package models_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/store/assets"
+	"github.com/smartcontractkit/chainlink/store/models"
+	"github.com/stretchr/testify/assert"
+	null "gopkg.in/guregu/null.v3"
+)
+
+func TestQueuedRun_Before(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	later := now.Add(time.Minute)
+
+	tests := []struct {
+		name  string
+		first models.QueuedRun
+		other models.QueuedRun
+		want  bool
+	}{
+		{
+			"higher payment",
+			models.QueuedRun{Payment: assets.NewLink(2), QueuedAt: later},
+			models.QueuedRun{Payment: assets.NewLink(1), QueuedAt: now},
+			true,
+		},
+		{
+			"lower payment",
+			models.QueuedRun{Payment: assets.NewLink(1), QueuedAt: now},
+			models.QueuedRun{Payment: assets.NewLink(2), QueuedAt: later},
+			false,
+		},
+		{
+			"payment over no payment",
+			models.QueuedRun{Payment: assets.NewLink(1), QueuedAt: later},
+			models.QueuedRun{QueuedAt: now},
+			true,
+		},
+		{
+			"expires sooner",
+			models.QueuedRun{ExpiresAt: null.TimeFrom(now), QueuedAt: later},
+			models.QueuedRun{ExpiresAt: null.TimeFrom(later), QueuedAt: now},
+			true,
+		},
+		{
+			"expires over no expiration",
+			models.QueuedRun{ExpiresAt: null.TimeFrom(later), QueuedAt: later},
+			models.QueuedRun{QueuedAt: now},
+			true,
+		},
+		{
+			"queued earlier",
+			models.QueuedRun{QueuedAt: now},
+			models.QueuedRun{QueuedAt: later},
+			true,
+		},
+		{
+			"queued later",
+			models.QueuedRun{QueuedAt: later},
+			models.QueuedRun{QueuedAt: now},
+			false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.want, test.first.Before(test.other))
+		})
+	}
+}
@@ -5,12 +5,14 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/smartcontractkit/chainlink/adapters"
 	"github.com/smartcontractkit/chainlink/internal/cltest"
 	"github.com/smartcontractkit/chainlink/store/models"
 	"github.com/smartcontractkit/chainlink/utils"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/tidwall/gjson"
 	null "gopkg.in/guregu/null.v3"
 )
@@ -60,6 +62,87 @@ func TestJobRun_NextTaskRun(t *testing.T) {
 	assert.Equal(t, &run.TaskRuns[1], run.NextTaskRun())
 }
 
+func TestJobRun_ReadyTaskRunIndexes(t *testing.T) {
+	t.Parallel()
+
+	job, initiator := cltest.NewJobWithWebInitiator()
+	job.Tasks = []models.TaskSpec{
+		{ID: "a", Type: adapters.TaskTypeNoOp},
+		{ID: "b", Type: adapters.TaskTypeNoOp},
+		{ID: "c", Type: adapters.TaskTypeNoOp, DependsOn: []string{"a"}},
+		{ID: "join", Type: adapters.TaskTypeNoOp, DependsOn: []string{"b", "c"}},
+	}
+	run := job.NewRun(initiator)
+	assert.True(t, run.IsGraph())
+	assert.Equal(t, []int{0, 1}, run.ReadyTaskRunIndexes())
+
+	run.TaskRuns[0] = run.TaskRuns[0].MarkCompleted()
+	assert.Equal(t, []int{1, 2}, run.ReadyTaskRunIndexes())
+
+	run.TaskRuns[1] = run.TaskRuns[1].MarkCompleted()
+	run.TaskRuns[2] = run.TaskRuns[2].MarkPendingConfirmations()
+	assert.Equal(t, []int{}, run.ReadyTaskRunIndexes())
+	assert.Equal(t, &run.TaskRuns[2], run.NextTaskRun())
+
+	run.TaskRuns[2] = run.TaskRuns[2].MarkCompleted()
+	assert.Equal(t, []int{3}, run.ReadyTaskRunIndexes())
+
+	parents := run.ParentTaskRuns(run.TaskRuns[3])
+	assert.Equal(t, []string{"b", "c"}, []string{parents[0].Task.ID, parents[1].Task.ID})
+}
+
+func TestJobRun_RequestExpired(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	tests := []struct {
+		name      string
+		expiresAt null.Time
+		want      bool
+	}{
+		{"no expiration", null.Time{}, false},
+		{"expires later", null.TimeFrom(now.Add(time.Second)), false},
+		{"expires now", null.TimeFrom(now), true},
+		{"expired", null.TimeFrom(now.Add(-time.Second)), true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			run := models.JobRun{ExpiresAt: test.expiresAt}
+			assert.Equal(t, test.want, run.RequestExpired(now))
+		})
+	}
+}
+
+func TestTaskRun_ApplyAttempt(t *testing.T) {
+	t.Parallel()
+
+	start := time.Now()
+	end := start.Add(time.Second)
+	tr := models.TaskRun{Task: models.TaskSpec{Retries: 1, RetryBackoff: models.Duration(time.Minute)}}
+
+	tr = tr.ApplyAttempt(models.RunResult{}.WithError(errors.New("boom")), start, end)
+	assert.Equal(t, models.RunStatusPendingRetry, tr.Status)
+	assert.Equal(t, end.Add(time.Minute), tr.RetryAt.Time)
+	require.Len(t, tr.Attempts, 1)
+	assert.Equal(t, "boom", tr.Attempts[0].ErrorMessage.String)
+
+	tr = tr.MarkRetrying()
+	assert.Equal(t, models.RunStatusUnstarted, tr.Status)
+	assert.False(t, tr.RetryAt.Valid)
+
+	tr = tr.ApplyAttempt(models.RunResult{}.WithError(errors.New("boom again")), start, end)
+	assert.Equal(t, models.RunStatusErrored, tr.Status)
+	assert.Equal(t, "boom again", tr.Result.Error())
+	assert.Len(t, tr.Attempts, 2)
+
+	tr = tr.MarkResumed()
+	assert.Equal(t, 2, tr.RetriesFrom)
+	tr = tr.ApplyAttempt(models.RunResult{}.WithError(errors.New("boom once resumed")), start, end)
+	assert.Equal(t, models.RunStatusPendingRetry, tr.Status, "a resumed task gets all of its retries again")
+	assert.Len(t, tr.Attempts, 3)
+}
+
 func TestRunResult_Value(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,98 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// secretReference matches placeholders such as {{secret "cmc_key"}} in task
+// params. It is matched against raw JSON, where the quotes around the name
+// are escaped.
+var secretReference = regexp.MustCompile(`\{\{\s*secret\s+\\?"([a-zA-Z0-9_\-]+)\\?"\s*\}\}`)
+
+// secretName restricts the names secrets can be stored under.
+var secretName = regexp.MustCompile(`^[a-zA-Z0-9_\-]+$`)
+
+// RedactedSecret replaces the value of a secret wherever it would be
+// rendered.
+const RedactedSecret = "*REDACTED*"
+
+// Secret is a value encrypted with the key derived from the keystore
+// password, which task params reference by name.
+type Secret struct {
+	Name       string    `json:"name" storm:"id,unique"`
+	Ciphertext []byte    `json:"ciphertext"`
+	CreatedAt  time.Time `json:"createdAt"`
+}
+
+// SecretRequest is the API request to store a secret.
+type SecretRequest struct {
+	Name  string `json:"name"`
+	Value string `json:"secret"`
+}
+
+// ValidateSecretName returns an error if the name cannot be referenced from
+// task params.
+func ValidateSecretName(name string) error {
+	if !secretName.MatchString(name) {
+		return NewValidationError("secret name %q must only contain letters, numbers, underscores and dashes", name)
+	}
+	return nil
+}
+
+// SecretReferences returns the names of the secrets referenced in the JSON.
+func SecretReferences(j JSON) []string {
+	var names []string
+	for _, match := range secretReference.FindAllStringSubmatch(j.String(), -1) {
+		names = append(names, match[1])
+	}
+	return names
+}
+
+// ResolveSecrets returns a copy of the JSON with the secret references
+// replaced by the values returned by lookup, along with the values used.
+func ResolveSecrets(j JSON, lookup func(name string) (string, error)) (JSON, []string, error) {
+	names := SecretReferences(j)
+	if len(names) == 0 {
+		return j, nil, nil
+	}
+
+	var values []string
+	var err error
+	resolved := secretReference.ReplaceAllStringFunc(j.String(), func(ref string) string {
+		if err != nil {
+			return ref
+		}
+		var value string
+		name := secretReference.FindStringSubmatch(ref)[1]
+		if value, err = lookup(name); err != nil {
+			err = fmt.Errorf("resolving secret %q: %v", name, err)
+			return ref
+		}
+		values = append(values, value)
+
+		// The reference sits inside a JSON string, so the value is escaped
+		// as one.
+		b, _ := json.Marshal(value)
+		return string(b[1 : len(b)-1])
+	})
+	if err != nil {
+		return j, nil, err
+	}
+
+	rval, err := ParseJSON([]byte(resolved))
+	return rval, values, err
+}
+
+// RedactSecrets replaces each of the secret values in the string.
+func RedactSecrets(s string, values []string) string {
+	for _, value := range values {
+		if value != "" {
+			s = strings.Replace(s, value, RedactedSecret, -1)
+		}
+	}
+	return s
+}
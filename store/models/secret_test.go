@@ -0,0 +1,90 @@
+package models_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/internal/cltest"
+	"github.com/smartcontractkit/chainlink/store/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveSecrets(t *testing.T) {
+	t.Parallel()
+
+	secrets := map[string]string{
+		"cmc_key": "abc123",
+		"quoted":  `a"b`,
+	}
+	lookup := func(name string) (string, error) {
+		if value, ok := secrets[name]; ok {
+			return value, nil
+		}
+		return "", errors.New("not found")
+	}
+
+	tests := []struct {
+		name       string
+		params     string
+		wantParams string
+		wantValues []string
+		wantErr    bool
+	}{
+		{"no references", `{"url":"https://example.com"}`, `{"url":"https://example.com"}`, nil, false},
+		{
+			"reference",
+			`{"url":"https://example.com?key={{secret \"cmc_key\"}}"}`,
+			`{"url":"https://example.com?key=abc123"}`,
+			[]string{"abc123"},
+			false,
+		},
+		{
+			"spaced reference",
+			`{"headers":{"X-Key":["{{ secret \"cmc_key\" }}"]}}`,
+			`{"headers":{"X-Key":["abc123"]}}`,
+			[]string{"abc123"},
+			false,
+		},
+		{"escaped value", `{"key":"{{secret \"quoted\"}}"}`, `{"key":"a\"b"}`, []string{`a"b`}, false},
+		{"missing secret", `{"key":"{{secret \"unknown\"}}"}`, "", nil, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			params := cltest.JSONFromString(test.params)
+			resolved, values, err := models.ResolveSecrets(params, lookup)
+			if test.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.JSONEq(t, test.wantParams, resolved.String())
+			assert.Equal(t, test.wantValues, values)
+			assert.Equal(t, test.params, params.String())
+		})
+	}
+}
+
+func TestSecretReferences(t *testing.T) {
+	t.Parallel()
+
+	j := cltest.JSONFromString(`{"a":"{{secret \"one\"}}","b":"{{secret \"two\"}}","c":"{{other \"three\"}}"}`)
+	assert.Equal(t, []string{"one", "two"}, models.SecretReferences(j))
+}
+
+func TestRedactSecrets(t *testing.T) {
+	t.Parallel()
+
+	redacted := models.RedactSecrets(`{"url":"https://example.com?key=abc123"}`, []string{"abc123", ""})
+	assert.Equal(t, `{"url":"https://example.com?key=*REDACTED*"}`, redacted)
+}
+
+func TestValidateSecretName(t *testing.T) {
+	t.Parallel()
+
+	assert.NoError(t, models.ValidateSecretName("cmc_key-2"))
+	assert.Error(t, models.ValidateSecretName(""))
+	assert.Error(t, models.ValidateSecretName(`cmc "key"`))
+}
@@ -0,0 +1,45 @@
+package models
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// SimulationRequest is a job spec and sample input to simulate a run with.
+type SimulationRequest struct {
+	Spec    JobSpecRequest `json:"spec"`
+	Input   JSON           `json:"input"`
+	EthCall bool           `json:"ethCall"`
+	From    common.Address `json:"from"`
+}
+
+// JobSimulation is the outcome of executing a job's tasks in memory, without
+// saving a run or sending transactions.
+type JobSimulation struct {
+	ID     string           `json:"id"`
+	Status RunStatus        `json:"status"`
+	Result RunResult        `json:"result"`
+	Tasks  []TaskSimulation `json:"tasks"`
+}
+
+// TaskSimulation is the input and output of a task executed by a simulation.
+type TaskSimulation struct {
+	Task   TaskSpec  `json:"task"`
+	Input  RunResult `json:"input"`
+	Output RunResult `json:"output"`
+}
+
+// GetID returns the ID of this structure for jsonapi serialization.
+func (js JobSimulation) GetID() string {
+	return js.ID
+}
+
+// GetName returns the pluralized "type" of this structure for jsonapi serialization.
+func (js JobSimulation) GetName() string {
+	return "simulations"
+}
+
+// SetID is used to set the ID of this structure when deserializing from jsonapi documents.
+func (js *JobSimulation) SetID(value string) error {
+	js.ID = value
+	return nil
+}
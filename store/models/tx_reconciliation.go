@@ -0,0 +1,60 @@
+package models
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	// TxReconcileConfirmed is recorded when an unconfirmed transaction is found
+	// to have been mined, and is now confirmed.
+	TxReconcileConfirmed = "confirmed"
+	// TxReconcileRebroadcast is recorded when the latest attempt of an
+	// unconfirmed transaction is sent again.
+	TxReconcileRebroadcast = "rebroadcast"
+	// TxReconcileGasBumped is recorded when the latest attempt of an
+	// unconfirmed transaction has waited ETH_GAS_BUMP_THRESHOLD blocks, and
+	// is replaced with one at a higher gas price.
+	TxReconcileGasBumped = "gas_bumped"
+	// TxReconcileReplaced is recorded when a transaction's nonce was used by
+	// a transaction sent outside of the node with the same key.
+	TxReconcileReplaced = "replaced"
+	// TxReconcileNonceGapFilled is recorded when a nonce with no transaction
+	// is filled with a zero value transaction to the account itself.
+	TxReconcileNonceGapFilled = "nonce_gap_filled"
+	// TxReconcileNonceReloaded is recorded when an account's nonce is behind
+	// the chain, and is reloaded from it.
+	TxReconcileNonceReloaded = "nonce_reloaded"
+)
+
+// TxReconciliation is an action taken to bring the node's transactions back
+// in line with the chain.
+type TxReconciliation struct {
+	ID        uint64         `json:"-"`
+	Action    string         `json:"action"`
+	From      common.Address `json:"from"`
+	Nonce     uint64         `json:"nonce"`
+	TxID      uint64         `json:"txId,omitempty"`
+	TxHash    *common.Hash   `json:"txHash,omitempty"`
+	CreatedAt time.Time      `json:"createdAt"`
+}
+
+// GetID returns the ID of this structure for jsonapi serialization.
+func (tr TxReconciliation) GetID() string {
+	return strconv.FormatUint(tr.ID, 10)
+}
+
+// GetName returns the pluralized "type" of this structure for jsonapi serialization.
+func (tr TxReconciliation) GetName() string {
+	return "tx_reconciliations"
+}
+
+// SetID is used to conform to the UnmarshallIdentifier interface for
+// deserializing from jsonapi documents.
+func (tr *TxReconciliation) SetID(value string) error {
+	id, err := strconv.ParseUint(value, 10, 64)
+	tr.ID = id
+	return err
+}
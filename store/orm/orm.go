@@ -1,6 +1,7 @@
 package orm
 
 import (
+	"crypto/rand"
 	"crypto/subtle"
 	"errors"
 	"fmt"
@@ -10,7 +11,6 @@ import (
 	"time"
 
 	"github.com/asdine/storm"
-	"github.com/asdine/storm/index"
 	"github.com/asdine/storm/q"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
@@ -19,6 +19,7 @@ import (
 	"github.com/smartcontractkit/chainlink/utils"
 	bolt "go.etcd.io/bbolt"
 	"go.uber.org/multierr"
+	null "gopkg.in/guregu/null.v3"
 )
 
 var (
@@ -118,6 +119,30 @@ func (orm *ORM) FindInitiatorsForJob(jobID string) ([]models.Initiator, error) {
 	return initrs, err
 }
 
+// FindChildInitiators returns the run completion initiators of active jobs
+// chained to the passed parent job. Those of paused and archived jobs are
+// left out, since they do not start runs.
+func (orm *ORM) FindChildInitiators(parentJobID string) ([]models.Initiator, error) {
+	initrs := []models.Initiator{}
+	if err := orm.Where("ParentJobID", parentJobID, &initrs); err != nil {
+		return initrs, err
+	}
+
+	active := []models.Initiator{}
+	for _, initr := range initrs {
+		job, err := orm.FindJob(initr.JobID)
+		if err == ErrorNotFound {
+			continue
+		} else if err != nil {
+			return active, err
+		}
+		if job.Active() {
+			active = append(active, initr)
+		}
+	}
+	return active, nil
+}
+
 // FindJobRun looks up a JobRun by its ID.
 func (orm *ORM) FindJobRun(id string) (models.JobRun, error) {
 	var jr models.JobRun
@@ -131,6 +156,34 @@ func (orm *ORM) SaveJobRun(run *models.JobRun) error {
 	return orm.DB.Save(run)
 }
 
+// UpdateJobRunOrphaned sets the creation height and orphaned time of the run
+// and adds a note, leaving the rest of the run, which the job runner may be
+// executing, as it is stored. The run is updated to the stored run.
+func (orm *ORM) UpdateJobRunOrphaned(run *models.JobRun, note models.RunNote) error {
+	tx, err := orm.Begin(true)
+	if err != nil {
+		return fmt.Errorf("error starting transaction: %+v", err)
+	}
+	defer tx.Rollback()
+
+	var current models.JobRun
+	if err := tx.One("ID", run.ID, &current); err != nil {
+		return err
+	}
+	current.CreationHeight = run.CreationHeight
+	current.OrphanedAt = run.OrphanedAt
+	current.Notes = append(current.Notes, note)
+	current.UpdatedAt = time.Now()
+	if err := tx.Save(&current); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	*run = current
+	return nil
+}
+
 // FindServiceAgreement looks up a ServiceAgreement by its ID.
 func (orm *ORM) FindServiceAgreement(id string) (models.ServiceAgreement, error) {
 	var sa models.ServiceAgreement
@@ -170,12 +223,35 @@ func (jrs jobRunSorterAscending) Less(i, j int) bool {
 	return jrs[i].CreatedAt.Sub(jrs[j].CreatedAt) > 0
 }
 
+// FindJobRunByLogKey looks up the run initiated by the log request with the
+// given key.
+func (orm *ORM) FindJobRunByLogKey(key string) (models.JobRun, error) {
+	var jr models.JobRun
+	return jr, orm.One("LogKey", key, &jr)
+}
+
 // JobRunsCountFor returns the current number of runs for the job
 func (orm *ORM) JobRunsCountFor(jobID string) (int, error) {
 	query := orm.Select(q.Eq("JobID", jobID))
 	return query.Count(&models.JobRun{})
 }
 
+// AnyUnfinishedJobRuns returns true if the job has a run that has neither
+// completed, errored, been cancelled nor expired.
+func (orm *ORM) AnyUnfinishedJobRuns(jobID string) (bool, error) {
+	query := orm.Select(
+		q.Eq("JobID", jobID),
+		q.Not(q.In("Status", []models.RunStatus{
+			models.RunStatusCompleted,
+			models.RunStatusErrored,
+			models.RunStatusCancelled,
+			models.RunStatusExpired,
+		})),
+	)
+	count, err := query.Count(&models.JobRun{})
+	return count > 0, err
+}
+
 // Sessions returns all sessions limited by the parameters.
 func (orm *ORM) Sessions(offset, limit int) ([]models.Session, error) {
 	var sessions []models.Session
@@ -210,6 +286,56 @@ func saveJobSpec(job *models.JobSpec, tx storm.Node) error {
 	return nil
 }
 
+// UpdateJob saves the next version of a job, keeping a snapshot of the
+// previous version and replacing its initiators.
+func (orm *ORM) UpdateJob(previous, next *models.JobSpec) error {
+	tx, err := orm.Begin(true)
+	if err != nil {
+		return fmt.Errorf("error starting transaction: %+v", err)
+	}
+	defer tx.Rollback()
+
+	version := models.NewJobSpecVersion(*previous)
+	if err := tx.Save(&version); err != nil {
+		return fmt.Errorf("error saving job version: %+v", err)
+	}
+	for i := range previous.Initiators {
+		if err := tx.DeleteStruct(&previous.Initiators[i]); err != nil && err != storm.ErrNotFound {
+			return fmt.Errorf("error deleting Job Initiators: %+v", err)
+		}
+	}
+	if err := saveJobSpec(next, tx); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// SetJobStatus updates the status of the job, leaving its initiators as
+// they are.
+func (orm *ORM) SetJobStatus(job *models.JobSpec, status models.JobSpecStatus, at time.Time) error {
+	tx, err := orm.Begin(true)
+	if err != nil {
+		return fmt.Errorf("error starting transaction: %+v", err)
+	}
+	defer tx.Rollback()
+
+	job.Status = status
+	job.UpdatedAt = models.Time{Time: at}
+	if err := tx.UpdateField(&models.JobSpec{ID: job.ID}, "Status", job.Status); err != nil {
+		return err
+	}
+	if err := tx.UpdateField(&models.JobSpec{ID: job.ID}, "UpdatedAt", job.UpdatedAt); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// FindJobVersion looks up the snapshot of a previous version of a job.
+func (orm *ORM) FindJobVersion(jobID string, version uint64) (models.JobSpecVersion, error) {
+	var jsv models.JobSpecVersion
+	return jsv, orm.One("ID", models.JobSpecVersionID(jobID, version), &jsv)
+}
+
 // SaveServiceAgreement saves a service agreement and it's associations to the
 // database.
 func (orm *ORM) SaveServiceAgreement(sa *models.ServiceAgreement) error {
@@ -242,6 +368,110 @@ func (orm *ORM) JobRunsWithStatus(statuses ...models.RunStatus) ([]models.JobRun
 	return runs, err
 }
 
+// SaveQueuedRun saves the run's entry in the job runner's queue.
+func (orm *ORM) SaveQueuedRun(qr *models.QueuedRun) error {
+	return orm.Save(qr)
+}
+
+// DeleteQueuedRun removes the queue entry of the run with the given ID.
+func (orm *ORM) DeleteQueuedRun(runID string) error {
+	return orm.DeleteStruct(&models.QueuedRun{RunID: runID})
+}
+
+// QueuedRuns returns the entries of all runs waiting in the job runner's
+// queue.
+func (orm *ORM) QueuedRuns() ([]models.QueuedRun, error) {
+	qrs := []models.QueuedRun{}
+	err := orm.All(&qrs)
+	if err == storm.ErrNotFound {
+		return []models.QueuedRun{}, nil
+	}
+	return qrs, err
+}
+
+// SaveSecret saves the encrypted secret, replacing any secret of the same
+// name.
+func (orm *ORM) SaveSecret(secret *models.Secret) error {
+	return orm.Save(secret)
+}
+
+// FindSecret looks up a secret by its name.
+func (orm *ORM) FindSecret(name string) (models.Secret, error) {
+	var secret models.Secret
+	return secret, orm.One("Name", name, &secret)
+}
+
+// DeleteSecret removes the secret with the given name.
+func (orm *ORM) DeleteSecret(name string) error {
+	return orm.DeleteStruct(&models.Secret{Name: name})
+}
+
+// secretsSaltLength is the length in bytes of the salt the key encrypting
+// secrets is derived with.
+const secretsSaltLength = 32
+
+// SecretsSalt returns the random salt the key encrypting secrets is derived
+// with, generating and saving it the first time. Keeping it with the secrets
+// it protects means the key does not change with the node's accounts.
+func (orm *ORM) SecretsSalt() ([]byte, error) {
+	tx, err := orm.Begin(true)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	var salt []byte
+	if err = tx.Get("SecretsSalt", "salt", &salt); err == nil {
+		return salt, nil
+	} else if err != ErrorNotFound {
+		return nil, err
+	}
+
+	salt = make([]byte, secretsSaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+	if err := tx.Set("SecretsSalt", "salt", salt); err != nil {
+		return nil, err
+	}
+	return salt, tx.Commit()
+}
+
+// Secrets returns every secret, sorted by name.
+func (orm *ORM) Secrets() ([]models.Secret, error) {
+	secrets := []models.Secret{}
+	err := orm.All(&secrets)
+	if err == storm.ErrNotFound {
+		return []models.Secret{}, nil
+	}
+	sort.Slice(secrets, func(i, j int) bool {
+		return secrets[i].Name < secrets[j].Name
+	})
+	return secrets, err
+}
+
+// FindKey returns the node's settings for the account with the given
+// address.
+func (orm *ORM) FindKey(address common.Address) (models.Key, error) {
+	var key models.Key
+	return key, orm.One("Address", address, &key)
+}
+
+// SaveKey saves the node's settings for an account.
+func (orm *ORM) SaveKey(key *models.Key) error {
+	return orm.Save(key)
+}
+
+// DeleteKey deletes the node's settings for the account with the given
+// address, if any.
+func (orm *ORM) DeleteKey(address common.Address) error {
+	err := orm.DeleteStruct(&models.Key{Address: address})
+	if err == storm.ErrNotFound {
+		return nil
+	}
+	return err
+}
+
 // AnyJobWithType returns true if there is at least one job associated with
 // the type name specified and false otherwise
 func (orm *ORM) AnyJobWithType(taskTypeName string) (bool, error) {
@@ -304,6 +534,37 @@ func (orm *ORM) ConfirmTx(tx *models.Tx, txat *models.TxAttempt) error {
 	return dbtx.Commit()
 }
 
+// UnconfirmTx reverts ConfirmTx, marking the transaction and its attempt as
+// no longer confirmed.
+func (orm *ORM) UnconfirmTx(tx *models.Tx, txat *models.TxAttempt) error {
+	dbtx, err := orm.Begin(true)
+	if err != nil {
+		return err
+	}
+	defer dbtx.Rollback()
+
+	txat.Confirmed = false
+	tx.TxAttempt = *txat
+	if err := dbtx.Save(tx); err != nil {
+		return err
+	}
+	if err := dbtx.Save(txat); err != nil {
+		return err
+	}
+	return dbtx.Commit()
+}
+
+// ConfirmedTxAttemptsSince returns the confirmed transaction attempts sent
+// after the given block number.
+func (orm *ORM) ConfirmedTxAttemptsSince(blockNumber uint64) ([]models.TxAttempt, error) {
+	attempts := []models.TxAttempt{}
+	err := orm.Select(q.Eq("Confirmed", true), q.Gt("SentAt", blockNumber)).Find(&attempts)
+	if err == storm.ErrNotFound {
+		return []models.TxAttempt{}, nil
+	}
+	return attempts, err
+}
+
 // FindTx returns the specific transaction for the passed ID.
 func (orm *ORM) FindTx(ID uint64) (*models.Tx, error) {
 	tx := &models.Tx{}
@@ -345,6 +606,7 @@ func (orm *ORM) AddTxAttempt(
 		Hex:      hex,
 		TxID:     tx.ID,
 		SentAt:   blkNum,
+		Cancels:  tx.Cancels,
 	}
 	if !tx.Confirmed {
 		tx.TxAttempt = *attempt
@@ -364,6 +626,24 @@ func (orm *ORM) AddTxAttempt(
 	return attempt, dbtx.Commit()
 }
 
+// RemoveTxAttempt reverts AddTxAttempt, deleting the attempt and saving the
+// transaction as it was before the attempt was added.
+func (orm *ORM) RemoveTxAttempt(tx *models.Tx, txat *models.TxAttempt) error {
+	dbtx, err := orm.Begin(true)
+	if err != nil {
+		return err
+	}
+	defer dbtx.Rollback()
+
+	if err := dbtx.DeleteStruct(txat); err != nil {
+		return err
+	}
+	if err := dbtx.Save(tx); err != nil {
+		return err
+	}
+	return dbtx.Commit()
+}
+
 // GetLastNonce retrieves the last known nonce in the database for an account
 func (orm *ORM) GetLastNonce(address common.Address) (uint64, error) {
 	var transactions []models.Tx
@@ -587,23 +867,27 @@ const (
 	Descending
 )
 
-func stormOrder(st SortType) func(*index.Options) {
-	so := func(opts *index.Options) {}
-	if st == Descending {
-		so = storm.Reverse()
+// JobsSorted returns many JobSpecs that have not been archived sorted by
+// CreatedAt from the store adhering to the passed parameters, along with the
+// total number of JobSpecs that have not been archived.
+func (orm *ORM) JobsSorted(order SortType, offset int, limit int) ([]models.JobSpec, int, error) {
+	unarchived := q.Not(q.Eq("Status", models.JobSpecStatusArchived))
+	count, err := orm.Select(unarchived).Count(&models.JobSpec{})
+	if err != nil {
+		return nil, 0, err
 	}
-	return so
-}
 
-// JobsSorted returns many JobSpecs sorted by CreatedAt from the store adhering
-// to the passed parameters.
-func (orm *ORM) JobsSorted(order SortType, offset int, limit int) ([]models.JobSpec, error) {
-	stormOffset := storm.Skip(offset)
-	stormLimit := storm.Limit(limit)
+	query := orm.Select(unarchived).OrderBy("CreatedAt").Limit(limit).Skip(offset)
+	if order == Descending {
+		query = query.Reverse()
+	}
 
 	var jobs []models.JobSpec
-	err := orm.AllByIndex("CreatedAt", &jobs, stormOrder(order), stormOffset, stormLimit)
-	return jobs, err
+	err = query.Find(&jobs)
+	if err == storm.ErrNotFound {
+		err = nil
+	}
+	return jobs, count, err
 }
 
 // TxFrom returns all transactions from a particular address.
@@ -613,6 +897,41 @@ func (orm *ORM) TxFrom(from common.Address) ([]models.Tx, error) {
 	return txs, err
 }
 
+// UnconfirmedTxs returns the transactions which have not been confirmed.
+func (orm *ORM) UnconfirmedTxs() ([]models.Tx, error) {
+	txs := []models.Tx{}
+	err := orm.Select(q.Eq("Confirmed", false)).Find(&txs)
+	if err == storm.ErrNotFound {
+		return []models.Tx{}, nil
+	}
+	return txs, err
+}
+
+// TxsFiltered returns the transactions from the given account, or from any
+// account if it is nil, whose confirmation matches confirmed unless it is
+// nil, newest first and limited by the passed params.
+func (orm *ORM) TxsFiltered(from *common.Address, confirmed *bool, offset, limit int) ([]models.Tx, int, error) {
+	matchers := []q.Matcher{}
+	if from != nil {
+		matchers = append(matchers, q.Eq("From", *from))
+	}
+	if confirmed != nil {
+		matchers = append(matchers, q.Eq("Confirmed", *confirmed))
+	}
+
+	count, err := orm.Select(matchers...).Count(&models.Tx{})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var txs []models.Tx
+	err = orm.Select(matchers...).OrderBy("ID").Reverse().Limit(limit).Skip(offset).Find(&txs)
+	if err == storm.ErrNotFound {
+		err = nil
+	}
+	return txs, count, err
+}
+
 // Transactions returns all transactions limited by passed parameters.
 func (orm *ORM) Transactions(offset, limit int) ([]models.Tx, error) {
 	var txs []models.Tx
@@ -719,6 +1038,12 @@ func (orm *ORM) SaveInitiator(initr *models.Initiator) error {
 	return orm.DB.Save(initr)
 }
 
+// MarkFired records the time at which a scheduled initiator last started a run.
+func (orm *ORM) MarkFired(i *models.Initiator, at time.Time) error {
+	i.LastFiredAt = null.TimeFrom(at)
+	return orm.DB.UpdateField(&models.Initiator{ID: i.ID}, "LastFiredAt", i.LastFiredAt)
+}
+
 // SaveHead saves the indexable block number related to head tracker.
 func (orm *ORM) SaveHead(n *models.IndexableBlockNumber) error {
 	return orm.DB.Save(n)
@@ -730,6 +1055,70 @@ func (orm *ORM) Save(data interface{}) error {
 	return nil
 }
 
+// SaveRecentHead saves the head in the window of recent heads used to detect
+// chain reorganisations.
+func (orm *ORM) SaveRecentHead(h *models.Head) error {
+	return orm.Save(h)
+}
+
+// FindRecentHead returns the recent head at the given height.
+func (orm *ORM) FindRecentHead(number uint64) (models.Head, error) {
+	var h models.Head
+	return h, orm.One("Number", number, &h)
+}
+
+// LastRecentHead returns the highest of the recent heads, or nil if none are
+// kept.
+func (orm *ORM) LastRecentHead() (*models.Head, error) {
+	heads := []models.Head{}
+	err := orm.Select().OrderBy("Number").Limit(1).Reverse().Find(&heads)
+	if err == storm.ErrNotFound {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return &heads[0], nil
+}
+
+// DeleteRecentHeadsAfter removes the recent heads above the given height.
+func (orm *ORM) DeleteRecentHeadsAfter(number uint64) error {
+	err := orm.Select(q.Gt("Number", number)).Delete(&models.Head{})
+	if err == storm.ErrNotFound {
+		return nil
+	}
+	return err
+}
+
+// PruneRecentHeads removes the recent heads below the given height.
+func (orm *ORM) PruneRecentHeads(number uint64) error {
+	err := orm.Select(q.Lt("Number", number)).Delete(&models.Head{})
+	if err == storm.ErrNotFound {
+		return nil
+	}
+	return err
+}
+
+// DeleteHeadsAfter removes the persisted block numbers above the given block
+// number, so that the last head rolls back to it.
+func (orm *ORM) DeleteHeadsAfter(n *models.IndexableBlockNumber) error {
+	numbers := []models.IndexableBlockNumber{}
+	err := orm.Select(q.Gte("Digits", n.Digits)).Find(&numbers)
+	if err == storm.ErrNotFound {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	for _, number := range numbers {
+		if number.GreaterThan(n) {
+			if err := orm.DeleteStruct(&number); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 // LastHead returns the last ordered IndexableBlockNumber.
 func (orm *ORM) LastHead() (*models.IndexableBlockNumber, error) {
 	numbers := []models.IndexableBlockNumber{}
@@ -14,6 +14,7 @@ import (
 	"github.com/smartcontractkit/chainlink/utils"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	null "gopkg.in/guregu/null.v3"
 )
 
 func TestWhereNotFound(t *testing.T) {
@@ -82,6 +83,35 @@ func TestJobRunsFor(t *testing.T) {
 	assert.Equal(t, []string{jr2.ID, jr1.ID, jr3.ID}, actual)
 }
 
+func TestORM_UpdateJobRunOrphaned(t *testing.T) {
+	t.Parallel()
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	job, initr := cltest.NewJobWithWebInitiator()
+	require.NoError(t, store.SaveJob(&job))
+	run := job.NewRun(initr)
+	require.NoError(t, store.SaveJobRun(&run))
+
+	stale := run
+	executed := run
+	executed.TaskRuns = append([]models.TaskRun{}, run.TaskRuns...)
+	executed.TaskRuns[0].Status = models.RunStatusCompleted
+	executed.AddNote(time.Now(), "executed")
+	require.NoError(t, store.SaveJobRun(&executed))
+
+	stale.OrphanedAt = null.TimeFrom(time.Now())
+	require.NoError(t, store.UpdateJobRunOrphaned(&stale, models.RunNote{CreatedAt: time.Now(), Text: "orphaned"}))
+
+	found, err := store.FindJobRun(run.ID)
+	require.NoError(t, err)
+	assert.True(t, found.Orphaned())
+	assert.Equal(t, models.RunStatusCompleted, found.TaskRuns[0].Status, "updating the orphaned run keeps the stored task runs")
+	require.Len(t, found.Notes, 2)
+	assert.Equal(t, "orphaned", found.Notes[1].Text)
+	assert.Equal(t, found.TaskRuns, stale.TaskRuns)
+}
+
 func TestORM_SaveServiceAgreement(t *testing.T) {
 	t.Parallel()
 	store, cleanup := cltest.NewStore()
@@ -213,6 +243,35 @@ func TestJobRunsCountFor(t *testing.T) {
 	assert.Equal(t, 1, count)
 }
 
+func TestORM_AnyUnfinishedJobRuns(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+	job, initr := cltest.NewJobWithSchedule("* * * * *")
+	assert.NoError(t, store.SaveJob(&job))
+
+	found, err := store.AnyUnfinishedJobRuns(job.ID)
+	assert.NoError(t, err)
+	assert.False(t, found)
+
+	completedRun := job.NewRun(initr)
+	completedRun.Status = models.RunStatusCompleted
+	assert.NoError(t, store.SaveJobRun(&completedRun))
+
+	found, err = store.AnyUnfinishedJobRuns(job.ID)
+	assert.NoError(t, err)
+	assert.False(t, found)
+
+	pendingRun := job.NewRun(initr)
+	pendingRun.Status = models.RunStatusPendingBridge
+	assert.NoError(t, store.SaveJobRun(&pendingRun))
+
+	found, err = store.AnyUnfinishedJobRuns(job.ID)
+	assert.NoError(t, err)
+	assert.True(t, found)
+}
+
 func TestCreatingTx(t *testing.T) {
 	t.Parallel()
 	store, cleanup := cltest.NewStore()
@@ -243,6 +302,64 @@ func TestCreatingTx(t *testing.T) {
 	assert.Equal(t, gasLimit, tx.GasLimit)
 }
 
+func TestORM_UnconfirmedTxs(t *testing.T) {
+	t.Parallel()
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	txs, err := store.UnconfirmedTxs()
+	require.NoError(t, err)
+	assert.Len(t, txs, 0)
+
+	from := cltest.NewAddress()
+	unconfirmed := cltest.CreateTxAndAttempt(store, from, 1)
+	confirmed := cltest.CreateTxAndAttempt(store, from, 2)
+	require.NoError(t, store.ConfirmTx(confirmed, &confirmed.TxAttempt))
+
+	txs, err = store.UnconfirmedTxs()
+	require.NoError(t, err)
+	require.Len(t, txs, 1)
+	assert.Equal(t, unconfirmed.ID, txs[0].ID)
+}
+
+func TestORM_TxsFiltered(t *testing.T) {
+	t.Parallel()
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	from := cltest.NewAddress()
+	unconfirmed := cltest.CreateTxAndAttempt(store, from, 1)
+	confirmed := cltest.CreateTxAndAttempt(store, from, 2)
+	require.NoError(t, store.ConfirmTx(confirmed, &confirmed.TxAttempt))
+	other := cltest.CreateTxAndAttempt(store, cltest.NewAddress(), 3)
+
+	yes, no := true, false
+	tests := []struct {
+		name      string
+		from      *common.Address
+		confirmed *bool
+		want      []uint64
+	}{
+		{"all", nil, nil, []uint64{other.ID, confirmed.ID, unconfirmed.ID}},
+		{"from", &from, nil, []uint64{confirmed.ID, unconfirmed.ID}},
+		{"unconfirmed", nil, &no, []uint64{other.ID, unconfirmed.ID}},
+		{"confirmed from", &from, &yes, []uint64{confirmed.ID}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			txs, count, err := store.TxsFiltered(test.from, test.confirmed, 0, 10)
+			require.NoError(t, err)
+			assert.Equal(t, len(test.want), count)
+			ids := []uint64{}
+			for _, tx := range txs {
+				ids = append(ids, tx.ID)
+			}
+			assert.Equal(t, test.want, ids)
+		})
+	}
+}
+
 func TestFindBridge(t *testing.T) {
 	t.Parallel()
 
@@ -620,3 +737,23 @@ func TestORM_AllInBatches_IncorrectCallback(t *testing.T) {
 		})
 	}
 }
+
+func TestORM_SecretsSalt(t *testing.T) {
+	t.Parallel()
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	salt, err := store.SecretsSalt()
+	require.NoError(t, err)
+	assert.Len(t, salt, 32)
+
+	again, err := store.SecretsSalt()
+	require.NoError(t, err)
+	assert.Equal(t, salt, again)
+
+	other, otherCleanup := cltest.NewStore()
+	defer otherCleanup()
+	otherSalt, err := other.SecretsSalt()
+	require.NoError(t, err)
+	assert.NotEqual(t, salt, otherSalt)
+}
@@ -0,0 +1,321 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/smartcontractkit/chainlink/logger"
+	"github.com/smartcontractkit/chainlink/store/models"
+	"github.com/smartcontractkit/chainlink/utils"
+)
+
+// maxPollingFailures is the number of polls in a row that can fail before
+// the subscription errors, as a websocket subscription would if its
+// connection dropped.
+const maxPollingFailures = 3
+
+// UnsupportedSubscriptionError is returned when subscribing to notifications
+// which cannot be polled for, such as newPendingTransactions, on a node
+// dialed over http.
+type UnsupportedSubscriptionError struct {
+	Name interface{}
+}
+
+func (err UnsupportedSubscriptionError) Error() string {
+	return fmt.Sprintf("%v subscriptions require a websocket connection to the Ethereum node, set ETH_URL to a ws:// or wss:// url", err.Name)
+}
+
+// pollingSubscriber emulates subscriptions to new heads and logs by polling
+// a node that cannot send notifications, such as one dialed over http.
+// Blocks within reorgDepth of the latest are polled again, so that the
+// subscriptions notice chain reorganisations.
+type pollingSubscriber struct {
+	caller     CallerSubscriber
+	interval   time.Duration
+	reorgDepth uint64
+}
+
+func newPollingSubscriber(caller CallerSubscriber, interval time.Duration, reorgDepth uint64) *pollingSubscriber {
+	return &pollingSubscriber{
+		caller:     caller,
+		interval:   interval,
+		reorgDepth: reorgDepth,
+	}
+}
+
+// Call performs the JSON-RPC call on the node.
+func (ps *pollingSubscriber) Call(result interface{}, method string, args ...interface{}) error {
+	return ps.caller.Call(result, method, args...)
+}
+
+// EthSubscribe polls for the new heads or logs the subscription is for,
+// sending those after the latest block at the time of subscribing on the
+// channel.
+func (ps *pollingSubscriber) EthSubscribe(ctx context.Context, channel interface{}, args ...interface{}) (models.EthSubscription, error) {
+	if len(args) == 0 {
+		return nil, errors.New("EthSubscribe requires the name of the subscription")
+	} else if args[0] != "newHeads" && args[0] != "logs" {
+		return nil, UnsupportedSubscriptionError{Name: args[0]}
+	}
+	latest, err := ps.blockNumber()
+	if err != nil {
+		return nil, err
+	}
+
+	var poll func(done <-chan struct{}) error
+	switch args[0] {
+	case "newHeads":
+		headers, ok := channel.(chan<- models.BlockHeader)
+		if !ok {
+			return nil, fmt.Errorf("cannot poll for new heads on a %T", channel)
+		}
+		header, err := ps.header(latest)
+		if err != nil {
+			return nil, err
+		}
+		poller := &headPoller{
+			ps:      ps,
+			headers: headers,
+			last:    latest,
+			hashes:  map[uint64]common.Hash{latest: header.Hash()},
+		}
+		poll = poller.poll
+	case "logs":
+		logs, ok := channel.(chan<- models.Log)
+		if !ok {
+			return nil, fmt.Errorf("cannot poll for logs on a %T", channel)
+		}
+		var filter map[string]interface{}
+		if len(args) > 1 {
+			if filter, ok = args[1].(map[string]interface{}); !ok {
+				return nil, fmt.Errorf("cannot poll for logs with a %T filter", args[1])
+			}
+		}
+		poller := &logPoller{
+			ps:     ps,
+			logs:   logs,
+			filter: filter,
+			start:  latest + 1,
+			next:   latest + 1,
+			seen:   map[logKey]models.Log{},
+		}
+		poll = poller.poll
+	}
+
+	return newPollingSubscription(poll, ps.interval), nil
+}
+
+func (ps *pollingSubscriber) blockNumber() (uint64, error) {
+	result := ""
+	if err := ps.caller.Call(&result, "eth_blockNumber"); err != nil {
+		return 0, err
+	}
+	return utils.HexToUint64(result)
+}
+
+func (ps *pollingSubscriber) header(number uint64) (models.BlockHeader, error) {
+	var header models.BlockHeader
+	err := ps.caller.Call(&header, "eth_getBlockByNumber", hexutil.EncodeUint64(number), false)
+	return header, err
+}
+
+// oldestPolled returns the first block to poll again, which is reorgDepth
+// blocks before next but never before floor.
+func (ps *pollingSubscriber) oldestPolled(next, floor uint64) uint64 {
+	if next > floor+ps.reorgDepth {
+		return next - ps.reorgDepth
+	}
+	return floor
+}
+
+type headPoller struct {
+	ps      *pollingSubscriber
+	headers chan<- models.BlockHeader
+	last    uint64
+	hashes  map[uint64]common.Hash
+}
+
+// poll sends the headers of the blocks after the last poll, including any
+// that were mined between polls. The header at the tip is also sent when its
+// hash has changed, so that reorganisations which do not lengthen the chain
+// are noticed.
+func (hp *headPoller) poll(done <-chan struct{}) error {
+	latest, err := hp.ps.blockNumber()
+	if err != nil {
+		return err
+	}
+
+	from := hp.ps.oldestPolled(latest, 0)
+	if latest <= hp.last {
+		from = latest
+	} else if hp.last+1 > from {
+		from = hp.last + 1
+	}
+
+	for n := from; n <= latest; n++ {
+		header, err := hp.ps.header(n)
+		if err != nil {
+			return err
+		}
+		if hash, ok := hp.hashes[n]; ok && hash == header.Hash() {
+			continue
+		}
+		select {
+		case hp.headers <- header:
+		case <-done:
+			return nil
+		}
+		hp.hashes[n] = header.Hash()
+	}
+
+	hp.last = latest
+	oldest := hp.ps.oldestPolled(latest, 0)
+	for n := range hp.hashes {
+		if n < oldest || n > latest {
+			delete(hp.hashes, n)
+		}
+	}
+	return nil
+}
+
+type logKey struct {
+	BlockHash common.Hash
+	TxHash    common.Hash
+	Index     uint
+}
+
+type logPoller struct {
+	ps     *pollingSubscriber
+	logs   chan<- models.Log
+	filter map[string]interface{}
+	start  uint64
+	next   uint64
+	seen   map[logKey]models.Log
+}
+
+// poll sends the logs in the blocks after the last poll. The blocks within
+// the reorganisation depth are queried again, so that logs moved to another
+// block are sent again and those no longer in the chain are sent as removed.
+func (lp *logPoller) poll(done <-chan struct{}) error {
+	latest, err := lp.ps.blockNumber()
+	if err != nil {
+		return err
+	}
+	if latest < lp.start {
+		return nil
+	}
+
+	from := lp.ps.oldestPolled(lp.next, lp.start)
+	if from > latest {
+		from = latest
+	}
+	filter := map[string]interface{}{}
+	for k, v := range lp.filter {
+		filter[k] = v
+	}
+	filter["fromBlock"] = hexutil.EncodeUint64(from)
+	filter["toBlock"] = hexutil.EncodeUint64(latest)
+
+	var logs []models.Log
+	if err := lp.ps.caller.Call(&logs, "eth_getLogs", filter); err != nil {
+		return err
+	}
+
+	current := map[logKey]bool{}
+	for _, log := range logs {
+		current[logKey{log.BlockHash, log.TxHash, log.Index}] = true
+	}
+	for key, log := range lp.seen {
+		if log.BlockNumber < from {
+			delete(lp.seen, key)
+		} else if !current[key] {
+			log.Removed = true
+			if !lp.send(log, done) {
+				return nil
+			}
+			delete(lp.seen, key)
+		}
+	}
+	for _, log := range logs {
+		key := logKey{log.BlockHash, log.TxHash, log.Index}
+		if _, ok := lp.seen[key]; ok {
+			continue
+		}
+		if !lp.send(log, done) {
+			return nil
+		}
+		lp.seen[key] = log
+	}
+
+	lp.next = latest + 1
+	return nil
+}
+
+func (lp *logPoller) send(log models.Log, done <-chan struct{}) bool {
+	select {
+	case lp.logs <- log:
+		return true
+	case <-done:
+		return false
+	}
+}
+
+// pollingSubscription polls every interval until it is unsubscribed, or
+// until maxPollingFailures polls in a row have failed.
+type pollingSubscription struct {
+	errors chan error
+	done   chan struct{}
+	wg     sync.WaitGroup
+	once   sync.Once
+}
+
+func newPollingSubscription(poll func(done <-chan struct{}) error, interval time.Duration) *pollingSubscription {
+	sub := &pollingSubscription{
+		errors: make(chan error, 1),
+		done:   make(chan struct{}),
+	}
+	sub.wg.Add(1)
+	go sub.run(poll, interval)
+	return sub
+}
+
+func (sub *pollingSubscription) run(poll func(done <-chan struct{}) error, interval time.Duration) {
+	defer sub.wg.Done()
+
+	failures := 0
+	for {
+		select {
+		case <-sub.done:
+			return
+		case <-time.After(interval):
+		}
+
+		if err := poll(sub.done); err == nil {
+			failures = 0
+		} else if failures++; failures < maxPollingFailures {
+			logger.Warnw("Error polling Ethereum node", "err", err)
+		} else {
+			sub.errors <- err
+			return
+		}
+	}
+}
+
+// Err returns the channel the error that ended polling is sent on.
+func (sub *pollingSubscription) Err() <-chan error {
+	return sub.errors
+}
+
+// Unsubscribe stops polling, returning once nothing more will be sent on
+// the subscription's channel.
+func (sub *pollingSubscription) Unsubscribe() {
+	sub.once.Do(func() {
+		close(sub.done)
+		sub.wg.Wait()
+	})
+}
@@ -0,0 +1,136 @@
+package store_test
+
+import (
+	"testing"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/smartcontractkit/chainlink/internal/cltest"
+	"github.com/smartcontractkit/chainlink/store"
+	"github.com/smartcontractkit/chainlink/store/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPollingSubscriber_SubscribeToNewHeads(t *testing.T) {
+	t.Parallel()
+
+	header := func(number int, hash common.Hash) models.BlockHeader {
+		return models.BlockHeader{Number: cltest.BigHexInt(number), GethHash: hash}
+	}
+	reorged := cltest.NewHash()
+
+	mock := &cltest.EthMock{}
+	mock.Register("eth_blockNumber", "0x5")
+	mock.Register("eth_getBlockByNumber", header(5, cltest.NewHash()))
+	mock.Register("eth_blockNumber", "0x7")
+	mock.Register("eth_getBlockByNumber", header(6, cltest.NewHash()))
+	mock.Register("eth_getBlockByNumber", header(7, cltest.NewHash()))
+	mock.Register("eth_blockNumber", "0x7")
+	mock.Register("eth_getBlockByNumber", header(7, reorged))
+
+	eth := &store.EthClient{CallerSubscriber: store.ExportedNewPollingSubscriber(mock, 10*time.Millisecond, 10)}
+	headers := make(chan models.BlockHeader)
+	sub, err := eth.SubscribeToNewHeads(headers)
+	require.NoError(t, err)
+	defer sub.Unsubscribe()
+
+	receive := func() models.BlockHeader {
+		select {
+		case h := <-headers:
+			return h
+		case <-time.After(time.Second):
+			t.Fatal("no header received")
+			return models.BlockHeader{}
+		}
+	}
+	assert.Equal(t, uint64(6), receive().Number.ToInt().Uint64())
+	assert.Equal(t, uint64(7), receive().Number.ToInt().Uint64())
+	assert.Equal(t, reorged, receive().Hash())
+	assert.True(t, mock.AllCalled())
+}
+
+func TestPollingSubscriber_SubscribeToLogs(t *testing.T) {
+	t.Parallel()
+
+	log := cltest.LogFromFixture("../internal/fixtures/eth/subscription_logs.json")
+	log.BlockNumber = 6
+	log.BlockHash = cltest.NewHash()
+	moved := log
+	moved.BlockNumber = 7
+	moved.BlockHash = cltest.NewHash()
+
+	filterRange := func(from, to string) func(interface{}, ...interface{}) error {
+		return func(_ interface{}, data ...interface{}) error {
+			filter := data[0].([]interface{})[0].(map[string]interface{})
+			assert.Equal(t, from, filter["fromBlock"])
+			assert.Equal(t, to, filter["toBlock"])
+			return nil
+		}
+	}
+
+	mock := &cltest.EthMock{}
+	mock.Register("eth_blockNumber", "0x5")
+	mock.Register("eth_blockNumber", "0x6")
+	mock.Register("eth_getLogs", []models.Log{log}, filterRange("0x6", "0x6"))
+	mock.Register("eth_blockNumber", "0x7")
+	mock.Register("eth_getLogs", []models.Log{moved}, filterRange("0x6", "0x7"))
+
+	eth := &store.EthClient{CallerSubscriber: store.ExportedNewPollingSubscriber(mock, 10*time.Millisecond, 10)}
+	logs := make(chan models.Log)
+	sub, err := eth.SubscribeToLogs(logs, ethereum.FilterQuery{Addresses: []common.Address{cltest.NewAddress()}})
+	require.NoError(t, err)
+	defer sub.Unsubscribe()
+
+	receive := func() models.Log {
+		select {
+		case l := <-logs:
+			return l
+		case <-time.After(time.Second):
+			t.Fatal("no log received")
+			return models.Log{}
+		}
+	}
+	first := receive()
+	assert.Equal(t, log.BlockHash, first.BlockHash)
+	assert.False(t, first.Removed)
+	removed := receive()
+	assert.Equal(t, log.BlockHash, removed.BlockHash)
+	assert.True(t, removed.Removed)
+	second := receive()
+	assert.Equal(t, moved.BlockHash, second.BlockHash)
+	assert.False(t, second.Removed)
+	assert.True(t, mock.AllCalled())
+}
+
+func TestPollingSubscriber_ErrorsAfterRepeatedFailures(t *testing.T) {
+	t.Parallel()
+
+	mock := &cltest.EthMock{}
+	mock.Register("eth_blockNumber", "0x5")
+	mock.Register("eth_getBlockByNumber", models.BlockHeader{Number: cltest.BigHexInt(5)})
+
+	eth := &store.EthClient{CallerSubscriber: store.ExportedNewPollingSubscriber(mock, time.Millisecond, 10)}
+	sub, err := eth.SubscribeToNewHeads(make(chan models.BlockHeader))
+	require.NoError(t, err)
+	defer sub.Unsubscribe()
+
+	select {
+	case err := <-sub.Err():
+		assert.Error(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("subscription did not error")
+	}
+}
+
+func TestPollingSubscriber_UnsupportedSubscription(t *testing.T) {
+	t.Parallel()
+
+	mock := &cltest.EthMock{}
+
+	eth := &store.EthClient{CallerSubscriber: store.ExportedNewPollingSubscriber(mock, time.Millisecond, 10)}
+	_, err := eth.SubscribeToPendingTransactions(make(chan models.PendingTransaction))
+	assert.Equal(t, store.UnsupportedSubscriptionError{Name: "newPendingTransactions"}, err)
+	assert.Contains(t, err.Error(), "websocket")
+}
@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"math/big"
 	"reflect"
+	"strconv"
 	"strings"
 	"time"
 
@@ -22,6 +23,7 @@ import (
 	"github.com/smartcontractkit/chainlink/utils"
 	"github.com/tidwall/gjson"
 	"go.uber.org/multierr"
+	null "gopkg.in/guregu/null.v3"
 )
 
 type requestType int
@@ -124,6 +126,18 @@ func (a *AccountBalance) SetID(value string) error {
 	return nil
 }
 
+// Key holds the hex representation of a keystore account's address, its ETH
+// & LINK balances, and whether it is kept from sending new transactions.
+type Key struct {
+	AccountBalance
+	SendDisabled bool `json:"sendDisabled"`
+}
+
+// GetName returns the collection name for jsonapi.
+func (k Key) GetName() string {
+	return "keys"
+}
+
 // ConfigWhitelist are the non-secret values of the node
 //
 // If you add an entry here, you should update NewConfigWhitelist and
@@ -141,18 +155,39 @@ type whitelist struct {
 	ClientNodeURL            string          `json:"clientNodeUrl"`
 	DatabaseTimeout          time.Duration   `json:"databaseTimeout"`
 	EthereumURL              string          `json:"ethUrl"`
+	EthereumSecondaryURLs    string          `json:"ethSecondaryUrls"`
+	EthereumSendOnlyURLs     string          `json:"ethSendOnlyUrls"`
+	EthNodeHealthCheckPeriod time.Duration   `json:"ethNodeHealthCheckPeriod"`
+	EthNodeMaxHeadAge        time.Duration   `json:"ethNodeMaxHeadAge"`
+	EthNodeMaxLatency        time.Duration   `json:"ethNodeMaxLatency"`
+	EthPollingInterval       time.Duration   `json:"ethPollingInterval"`
+	EthBalanceMonitorBlocks  uint64          `json:"ethBalanceMonitorBlocks"`
+	EthBalanceThreshold      *big.Int        `json:"ethBalanceThreshold"`
 	EthGasBumpThreshold      uint64          `json:"ethGasBumpThreshold"`
 	EthGasBumpWei            *big.Int        `json:"ethGasBumpWei"`
 	EthGasPriceDefault       *big.Int        `json:"ethGasPriceDefault"`
+	EthGasPriceEstimator     string          `json:"ethGasPriceEstimator"`
+	EthGasPriceBlockHistory  uint64          `json:"ethGasPriceBlockHistory"`
+	EthGasPricePercentile    uint64          `json:"ethGasPricePercentile"`
+	EthGasPriceMultiplier    float64         `json:"ethGasPriceMultiplier"`
+	EthGasPriceMin           *big.Int        `json:"ethGasPriceMin"`
+	EthGasPriceMax           *big.Int        `json:"ethGasPriceMax"`
+	EthGasLimitEstimation    bool            `json:"ethGasLimitEstimation"`
+	EthGasLimitMultiplier    float64         `json:"ethGasLimitMultiplier"`
+	EthTxPreflight           bool            `json:"ethTxPreflight"`
+	EthTxReconcilePeriod     time.Duration   `json:"ethTxReconcilePeriod"`
+	HeadHistoryDepth         uint64          `json:"headHistoryDepth"`
 	JSONConsole              bool            `json:"jsonConsole"`
 	LinkContractAddress      string          `json:"linkContractAddress"`
 	LogLevel                 store.LogLevel  `json:"logLevel"`
 	LogToDisk                bool            `json:"logToDisk"`
+	MaxConcurrentRuns        uint64          `json:"maxConcurrentRuns"`
 	MinimumContractPayment   *assets.Link    `json:"minimumContractPayment"`
 	MinimumRequestExpiration uint64          `json:"minimumRequestExpiration"`
 	MinIncomingConfirmations uint64          `json:"minIncomingConfirmations"`
 	MinOutgoingConfirmations uint64          `json:"minOutgoingConfirmations"`
 	OracleContractAddress    *common.Address `json:"oracleContractAddress"`
+	OrphanedRunTimeout       time.Duration   `json:"orphanedRunTimeout"`
 	Port                     uint16          `json:"chainlinkPort"`
 	ReaperExpiration         time.Duration   `json:"reaperExpiration"`
 	RootDir                  string          `json:"root"`
@@ -179,18 +214,39 @@ func NewConfigWhitelist(store *store.Store) (ConfigWhitelist, error) {
 			ClientNodeURL:            config.ClientNodeURL(),
 			DatabaseTimeout:          config.DatabaseTimeout(),
 			EthereumURL:              config.EthereumURL(),
+			EthereumSecondaryURLs:    config.EthereumSecondaryURLs(),
+			EthereumSendOnlyURLs:     config.EthereumSendOnlyURLs(),
+			EthNodeHealthCheckPeriod: config.EthNodeHealthCheckPeriod(),
+			EthNodeMaxHeadAge:        config.EthNodeMaxHeadAge(),
+			EthNodeMaxLatency:        config.EthNodeMaxLatency(),
+			EthPollingInterval:       config.EthPollingInterval(),
+			EthBalanceMonitorBlocks:  config.EthBalanceMonitorBlocks(),
+			EthBalanceThreshold:      config.EthBalanceThreshold(),
 			EthGasBumpThreshold:      config.EthGasBumpThreshold(),
 			EthGasBumpWei:            config.EthGasBumpWei(),
 			EthGasPriceDefault:       config.EthGasPriceDefault(),
+			EthGasPriceEstimator:     config.EthGasPriceEstimator(),
+			EthGasPriceBlockHistory:  config.EthGasPriceBlockHistory(),
+			EthGasPricePercentile:    config.EthGasPricePercentile(),
+			EthGasPriceMultiplier:    config.EthGasPriceMultiplier(),
+			EthGasPriceMin:           config.EthGasPriceMin(),
+			EthGasPriceMax:           config.EthGasPriceMax(),
+			EthGasLimitEstimation:    config.EthGasLimitEstimation(),
+			EthGasLimitMultiplier:    config.EthGasLimitMultiplier(),
+			EthTxPreflight:           config.EthTxPreflight(),
+			EthTxReconcilePeriod:     config.EthTxReconcilePeriod(),
+			HeadHistoryDepth:         config.HeadHistoryDepth(),
 			JSONConsole:              config.JSONConsole(),
 			LinkContractAddress:      config.LinkContractAddress(),
 			LogLevel:                 config.LogLevel(),
 			LogToDisk:                config.LogToDisk(),
+			MaxConcurrentRuns:        config.MaxConcurrentRuns(),
 			MinimumContractPayment:   config.MinimumContractPayment(),
 			MinimumRequestExpiration: config.MinimumRequestExpiration(),
 			MinIncomingConfirmations: config.MinIncomingConfirmations(),
 			MinOutgoingConfirmations: config.MinOutgoingConfirmations(),
 			OracleContractAddress:    config.OracleContractAddress(),
+			OrphanedRunTimeout:       config.OrphanedRunTimeout(),
 			Port:                     config.Port(),
 			ReaperExpiration:         config.ReaperExpiration(),
 			RootDir:                  config.RootDir(),
@@ -339,19 +395,38 @@ func initiatorParams(i Initiator) (interface{}, error) {
 		return struct{}{}, nil
 	case models.InitiatorCron:
 		return struct {
-			Schedule models.Cron `json:"schedule"`
-		}{i.Schedule}, nil
+			Schedule      models.Cron     `json:"schedule"`
+			Timezone      string          `json:"timezone,omitempty"`
+			Jitter        models.Duration `json:"jitter,omitempty"`
+			OverlapPolicy string          `json:"overlapPolicy,omitempty"`
+			MisfirePolicy string          `json:"misfirePolicy"`
+			MisfireLimit  uint            `json:"misfireLimit,omitempty"`
+			LastFiredAt   null.Time       `json:"lastFiredAt"`
+		}{i.Schedule, i.Timezone, i.Jitter, i.OverlapPolicy, i.EffectiveMisfirePolicy(), i.MisfireLimit, i.LastFiredAt}, nil
 	case models.InitiatorRunAt:
 		return struct {
-			Time models.Time `json:"time"`
-			Ran  bool        `json:"ran"`
-		}{i.Time, i.Ran}, nil
+			Time          models.Time `json:"time"`
+			Ran           bool        `json:"ran"`
+			MisfirePolicy string      `json:"misfirePolicy"`
+			LastFiredAt   null.Time   `json:"lastFiredAt"`
+		}{i.Time, i.Ran, i.EffectiveMisfirePolicy(), i.LastFiredAt}, nil
 	case models.InitiatorEthLog:
 		fallthrough
 	case models.InitiatorRunLog:
 		return struct {
 			Address common.Address `json:"address"`
 		}{i.Address}, nil
+	case models.InitiatorRunCompletion:
+		return struct {
+			ParentJobID    string             `json:"parentJobId"`
+			ParentStatuses []models.RunStatus `json:"parentStatuses"`
+		}{i.ParentJobID, i.ParentStatuses}, nil
+	case models.InitiatorPendingTransaction:
+		return struct {
+			ToAddresses      []common.Address         `json:"toAddresses"`
+			FromAddresses    []common.Address         `json:"fromAddresses"`
+			FunctionSelector *models.FunctionSelector `json:"functionSelector,omitempty"`
+		}{i.ToAddresses, i.FromAddresses, i.FunctionSelector}, nil
 	default:
 		return nil, fmt.Errorf("Cannot marshal unsupported initiator type %v", i.Type)
 	}
@@ -485,3 +560,79 @@ func (a NewAccount) GetID() string {
 func (a NewAccount) GetName() string {
 	return "keys"
 }
+
+// Secret presents the name of a secret without its encrypted value.
+type Secret struct {
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// NewSecret returns the presentation of the secret.
+func NewSecret(s models.Secret) Secret {
+	return Secret{Name: s.Name, CreatedAt: s.CreatedAt}
+}
+
+// GetID returns the jsonapi ID.
+func (s Secret) GetID() string {
+	return s.Name
+}
+
+// GetName returns the collection name for jsonapi.
+func (s Secret) GetName() string {
+	return "secrets"
+}
+
+// SetID is used to set the ID of this structure when deserializing from jsonapi documents.
+func (s *Secret) SetID(value string) error {
+	s.Name = value
+	return nil
+}
+
+// Tx presents a transaction sent by the node along with its latest attempt.
+type Tx struct {
+	ID        uint64         `json:"-"`
+	From      common.Address `json:"from"`
+	To        common.Address `json:"to"`
+	Nonce     uint64         `json:"nonce"`
+	Value     *big.Int       `json:"value"`
+	GasLimit  uint64         `json:"gasLimit"`
+	Hash      common.Hash    `json:"hash"`
+	GasPrice  *big.Int       `json:"gasPrice"`
+	SentAt    uint64         `json:"sentAt"`
+	Confirmed bool           `json:"confirmed"`
+	Replaced  bool           `json:"replaced"`
+}
+
+// NewTx returns the presentation of the transaction.
+func NewTx(tx models.Tx) Tx {
+	return Tx{
+		ID:        tx.ID,
+		From:      tx.From,
+		To:        tx.To,
+		Nonce:     tx.Nonce,
+		Value:     tx.Value,
+		GasLimit:  tx.GasLimit,
+		Hash:      tx.Hash,
+		GasPrice:  tx.GasPrice,
+		SentAt:    tx.SentAt,
+		Confirmed: tx.Confirmed,
+		Replaced:  tx.Replaced,
+	}
+}
+
+// GetID returns the jsonapi ID.
+func (t Tx) GetID() string {
+	return strconv.FormatUint(t.ID, 10)
+}
+
+// GetName returns the collection name for jsonapi.
+func (t Tx) GetName() string {
+	return "transactions"
+}
+
+// SetID is used to set the ID of this structure when deserializing from jsonapi documents.
+func (t *Tx) SetID(value string) error {
+	id, err := strconv.ParseUint(value, 10, 64)
+	t.ID = id
+	return err
+}
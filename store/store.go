@@ -22,12 +22,16 @@ import (
 // for keeping the application state in sync with the database.
 type Store struct {
 	*orm.ORM
-	Config     Config
-	Clock      AfterNower
-	KeyStore   *KeyStore
-	RunChannel RunChannel
-	TxManager  TxManager
-	closed     bool
+	Config            Config
+	Clock             AfterNower
+	KeyStore          *KeyStore
+	EthNodes          *EthNodes
+	GasPriceEstimator *GasPriceEstimator
+	RunChannel        RunChannel
+	TxManager         TxManager
+	TxReconciler      *TxReconciler
+	BalanceMonitor    *BalanceMonitor
+	closed            bool
 }
 
 type lazyRPCWrapper struct {
@@ -37,13 +41,15 @@ type lazyRPCWrapper struct {
 	initialized *abool.AtomicBool
 }
 
-func newLazyRPCWrapper(urlString string) (CallerSubscriber, error) {
+func newLazyRPCWrapper(urlString string) (*lazyRPCWrapper, error) {
 	parsed, err := url.ParseRequestURI(urlString)
 	if err != nil {
 		return nil, err
 	}
-	if parsed.Scheme != "ws" && parsed.Scheme != "wss" {
-		return nil, fmt.Errorf("Ethereum url scheme must be websocket: %s", parsed.String())
+	switch parsed.Scheme {
+	case "ws", "wss", "http", "https":
+	default:
+		return nil, fmt.Errorf("Ethereum url scheme must be websocket or http: %s", parsed.String())
 	}
 	return &lazyRPCWrapper{
 		url:         parsed,
@@ -97,19 +103,29 @@ type Dialer interface {
 
 // EthDialer is Dialer which accesses rpc urls
 type EthDialer struct {
-	url models.WebURL
+	url    models.WebURL
+	config Config
 }
 
-// Dial will dial the given url and return a CallerSubscriber
+// Dial will dial the given url and return a CallerSubscriber. Nodes only
+// send notifications over websockets, so subscriptions to nodes dialed over
+// http are emulated by polling.
 func (ed *EthDialer) Dial(urlString string) (CallerSubscriber, error) {
-	return newLazyRPCWrapper(urlString)
+	wrapper, err := newLazyRPCWrapper(urlString)
+	if err != nil {
+		return nil, err
+	}
+	if wrapper.url.Scheme == "http" || wrapper.url.Scheme == "https" {
+		return newPollingSubscriber(wrapper, ed.config.EthPollingInterval(), ed.config.HeadHistoryDepth()), nil
+	}
+	return wrapper, nil
 }
 
 // NewStore will create a new database file at the config's RootDir if
 // it is not already present, otherwise it will use the existing db.bolt
 // file.
 func NewStore(config Config) *Store {
-	return NewStoreWithDialer(config, &EthDialer{})
+	return NewStoreWithDialer(config, &EthDialer{config: config})
 }
 
 // NewStoreWithDialer creates a new store with the given config and dialer
@@ -122,19 +138,24 @@ func NewStoreWithDialer(config Config, dialer Dialer) *Store {
 	if err != nil {
 		logger.Fatal(fmt.Sprintf("Unable to initialize ORM: %+v", err))
 	}
-	ethrpc, err := dialer.Dial(config.EthereumURL())
+	ethNodes, err := NewEthNodes(config, dialer)
 	if err != nil {
 		logger.Fatal(fmt.Sprintf("Unable to dial ETH RPC port: %+v", err))
 	}
-	keyStore := NewKeyStore(config.KeysDir())
+	keyStore := NewKeyStore(config.KeysDir(), orm.SecretsSalt)
+	txManager := NewEthTxManager(&EthClient{ethNodes}, config, keyStore, orm)
 
 	store := &Store{
-		Clock:      Clock{},
-		Config:     config,
-		KeyStore:   keyStore,
-		ORM:        orm,
-		RunChannel: NewQueuedRunChannel(),
-		TxManager:  NewEthTxManager(&EthClient{ethrpc}, config, keyStore, orm),
+		Clock:             Clock{},
+		Config:            config,
+		KeyStore:          keyStore,
+		EthNodes:          ethNodes,
+		GasPriceEstimator: txManager.GasPriceEstimator(),
+		ORM:               orm,
+		RunChannel:        NewQueuedRunChannel(),
+		TxManager:         txManager,
+		TxReconciler:      txManager.TxReconciler(),
+		BalanceMonitor:    txManager.BalanceMonitor(),
 	}
 	return store
 }
@@ -142,11 +163,13 @@ func NewStoreWithDialer(config Config, dialer Dialer) *Store {
 // Start initiates all of Store's dependencies including the TxManager.
 func (s *Store) Start() error {
 	s.TxManager.Register(s.KeyStore.Accounts())
+	s.EthNodes.Start()
 	return nil
 }
 
 // Close shuts down all of the working parts of the store.
 func (s *Store) Close() error {
+	s.EthNodes.Stop()
 	s.RunChannel.Close()
 	return s.ORM.Close()
 }
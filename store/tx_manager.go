@@ -10,7 +10,9 @@ import (
 	ethereum "github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/smartcontractkit/chainlink/logger"
 	"github.com/smartcontractkit/chainlink/store/assets"
 	"github.com/smartcontractkit/chainlink/store/models"
@@ -26,17 +28,72 @@ import (
 const DefaultGasLimit uint64 = 500000
 const nonceReloadLimit uint = 1
 
+// selfSendGasLimit is the gas used by a transaction with no data sent to an
+// account rather than a contract.
+const selfSendGasLimit uint64 = 21000
+
 // ErrPendingConnection is the error returned if TxManager is not connected.
 var ErrPendingConnection = errors.New("Cannot talk to chain, pending connection")
 
+// ErrLowBalance is the error returned when creating a transaction while every
+// account with sending enabled has no ETH or a balance below
+// ETH_BALANCE_THRESHOLD.
+var ErrLowBalance = errors.New("All accounts with sending enabled have no ETH or a balance below ETH_BALANCE_THRESHOLD, top one up before creating a transaction")
+
+// ErrUnconfirmedTxs is the error returned when removing an account which has
+// transactions still to be confirmed.
+var ErrUnconfirmedTxs = errors.New("Key has unconfirmed transactions, which could no longer be bumped; wait for them to be confirmed before deleting it")
+
+// ErrTxConfirmed is the error returned when replacing a transaction that has
+// already been confirmed.
+var ErrTxConfirmed = errors.New("Transaction has already been confirmed")
+
+// ErrTxReplaced is the error returned when waiting on a transaction whose
+// nonce was used by a transaction sent outside of the node with the same key,
+// so that it can never be confirmed.
+var ErrTxReplaced = errors.New("Transaction was replaced by another transaction with the same nonce, sent outside of the node with the same key")
+
+// ErrTxCancelled is the error returned when waiting on a transaction whose
+// cancellation, a zero value transaction to its sender, was confirmed.
+var ErrTxCancelled = errors.New("Transaction was cancelled, replaced by a zero value transaction to its sender")
+
+// ReplacementUnderpricedError is the error returned when replacing a
+// transaction with a gas price no higher than that of its latest attempt.
+type ReplacementUnderpricedError struct {
+	GasPrice *big.Int
+	Current  *big.Int
+}
+
+func (e *ReplacementUnderpricedError) Error() string {
+	return fmt.Sprintf("gas price %v must be higher than the current gas price of %v", e.GasPrice, e.Current)
+}
+
+// RevertError is the error returned when a transaction reverts, or would
+// revert, with the reason the contract gave if any.
+type RevertError struct {
+	Reason string
+}
+
+func (e *RevertError) Error() string {
+	if e.Reason == "" {
+		return "transaction reverted"
+	}
+	return fmt.Sprintf("transaction reverted: %s", e.Reason)
+}
+
 // TxManager represents an interface for interacting with the blockchain
 type TxManager interface {
 	HeadTrackable
 	Connected() bool
 	Register(accounts []accounts.Account)
+	AddAccount(account accounts.Account)
+	RemoveAccount(address common.Address) error
+	SetSendDisabled(address common.Address, disabled bool) error
 	CreateTx(to common.Address, data []byte) (*models.Tx, error)
-	CreateTxWithGas(to common.Address, data []byte, gasPriceWei *big.Int, gasLimit uint64) (*models.Tx, error)
+	CreateTxWithGas(to common.Address, data []byte, gasPriceWei *big.Int, gasLimit uint64, maxGasLimit uint64) (*models.Tx, error)
 	CreateTxWithEth(to common.Address, value *assets.Eth) (*models.Tx, error)
+	SpeedUpTx(hash common.Hash, gasPrice *big.Int) (*models.Tx, error)
+	CancelTx(hash common.Hash, gasPrice *big.Int) (*models.Tx, error)
 	BumpGasUntilSafe(hash common.Hash) (*TxReceipt, error)
 	ContractLINKBalance(wr models.WithdrawalRequest) (assets.Link, error)
 	WithdrawLINK(wr models.WithdrawalRequest) (common.Hash, error)
@@ -48,6 +105,10 @@ type TxManager interface {
 	GetBlockByNumber(hex string) (models.BlockHeader, error)
 	SubscribeToLogs(channel chan<- models.Log, q ethereum.FilterQuery) (models.EthSubscription, error)
 	GetLogs(q ethereum.FilterQuery) ([]models.Log, error)
+	SubscribeToPendingTransactions(channel chan<- models.PendingTransaction) (models.EthSubscription, error)
+	GetTransactionByHash(hash common.Hash) (models.PendingTransaction, error)
+	GetTxReceipt(hash common.Hash) (*TxReceipt, error)
+	CallContract(from, to common.Address, data []byte) (hexutil.Bytes, error)
 }
 
 //go:generate mockgen -package=mocks -destination=../internal/mocks/tx_manager_mocks.go github.com/smartcontractkit/chainlink/store TxManager
@@ -64,12 +125,20 @@ type EthTxManager struct {
 	availableAccountIdx int
 	accountsMutex       *sync.Mutex
 	connected           *abool.AtomicBool
+	gasPriceEstimator   *GasPriceEstimator
+	txReconciler        *TxReconciler
+	balanceMonitor      *BalanceMonitor
+	txLocks             [txLockStripes]sync.Mutex
 }
 
+// txLockStripes is the number of locks the attempts of transactions are
+// serialized with, each transaction always using the same one.
+const txLockStripes = 64
+
 // NewEthTxManager constructs an EthTxManager using the passed variables and
 // initializing internal variables.
 func NewEthTxManager(ethClient *EthClient, config Config, keyStore *KeyStore, orm *orm.ORM) *EthTxManager {
-	return &EthTxManager{
+	txm := &EthTxManager{
 		EthClient:     ethClient,
 		config:        config,
 		keyStore:      keyStore,
@@ -77,6 +146,27 @@ func NewEthTxManager(ethClient *EthClient, config Config, keyStore *KeyStore, or
 		accountsMutex: &sync.Mutex{},
 		connected:     abool.New(),
 	}
+	txm.gasPriceEstimator = newGasPriceEstimator(txm)
+	txm.txReconciler = newTxReconciler(txm)
+	txm.balanceMonitor = newBalanceMonitor(txm)
+	return txm
+}
+
+// GasPriceEstimator returns the estimator of the gas price transactions are
+// sent with.
+func (txm *EthTxManager) GasPriceEstimator() *GasPriceEstimator {
+	return txm.gasPriceEstimator
+}
+
+// TxReconciler returns the reconciler of the transactions sent by the
+// accounts.
+func (txm *EthTxManager) TxReconciler() *TxReconciler {
+	return txm.txReconciler
+}
+
+// BalanceMonitor returns the monitor of the accounts' ETH balances.
+func (txm *EthTxManager) BalanceMonitor() *BalanceMonitor {
+	return txm.balanceMonitor
 }
 
 // Register activates accounts for outgoing transactions and client side
@@ -90,6 +180,99 @@ func (txm *EthTxManager) Register(accts []accounts.Account) {
 	txm.registeredAccounts = cp
 }
 
+// AddAccount registers an account added to the keystore while the node is
+// running, activating it straight away if connected. If it cannot be
+// activated, it is on the next connection.
+func (txm *EthTxManager) AddAccount(account accounts.Account) {
+	var ma *ManagedAccount
+	if txm.Connected() {
+		var err error
+		if ma, err = txm.activateAccount(account); err != nil {
+			logger.Warnw("Unable to activate added account until reconnecting", "address", account.Address.Hex(), "err", err)
+		}
+	}
+
+	txm.accountsMutex.Lock()
+	defer txm.accountsMutex.Unlock()
+
+	txm.registeredAccounts = append(txm.registeredAccounts, account)
+	if ma != nil {
+		txm.availableAccounts = append(txm.availableAccounts, ma)
+	}
+}
+
+// RemoveAccount stops using an account about to be deleted from the
+// keystore. It returns ErrUnconfirmedTxs instead if the account has sent
+// transactions which are neither confirmed nor replaced, since they could
+// no longer be bumped once its key is gone.
+func (txm *EthTxManager) RemoveAccount(address common.Address) error {
+	ma := txm.takeAvailableAccount(address)
+	if ma != nil {
+		// Wait for any transaction being created from the account
+		ma.mutex.Lock()
+		defer ma.mutex.Unlock()
+	}
+
+	txs, err := txm.orm.TxFrom(address)
+	for _, tx := range txs {
+		if !tx.Confirmed && !tx.Replaced {
+			err = ErrUnconfirmedTxs
+			break
+		}
+	}
+
+	txm.accountsMutex.Lock()
+	defer txm.accountsMutex.Unlock()
+
+	if err != nil {
+		if ma != nil {
+			txm.availableAccounts = append(txm.availableAccounts, ma)
+		}
+		return err
+	}
+	registered := []accounts.Account{}
+	for _, a := range txm.registeredAccounts {
+		if a.Address != address {
+			registered = append(registered, a)
+		}
+	}
+	txm.registeredAccounts = registered
+	txm.balanceMonitor.forget(address)
+	return nil
+}
+
+// takeAvailableAccount removes the account with the given address from the
+// rotation for sending transactions, returning it if it was in it.
+func (txm *EthTxManager) takeAvailableAccount(address common.Address) *ManagedAccount {
+	txm.accountsMutex.Lock()
+	defer txm.accountsMutex.Unlock()
+
+	for i, ma := range txm.availableAccounts {
+		if ma.Address == address {
+			txm.availableAccounts = append(txm.availableAccounts[:i:i], txm.availableAccounts[i+1:]...)
+			if txm.availableAccountIdx >= len(txm.availableAccounts) {
+				txm.availableAccountIdx = 0
+			}
+			return ma
+		}
+	}
+	return nil
+}
+
+// SetSendDisabled saves whether the account is kept from sending new
+// transactions, which NextActiveAccount skips it for. Transactions the
+// account has already sent are still bumped until they are confirmed.
+func (txm *EthTxManager) SetSendDisabled(address common.Address, disabled bool) error {
+	key := models.Key{Address: address, SendDisabled: disabled}
+	if err := txm.orm.SaveKey(&key); err != nil {
+		return err
+	}
+	if ma := txm.getAccount(address); ma != nil {
+		ma.sendDisabled.SetTo(disabled)
+	}
+	return nil
+}
+
 // Connected returns a bool indicating whether or not it is connected.
 func (txm *EthTxManager) Connected() bool {
 	return txm.connected.IsSet()
@@ -123,22 +306,151 @@ func (txm *EthTxManager) Disconnect() {
 // OnNewHead does nothing; exists to comply with interface.
 func (txm *EthTxManager) OnNewHead(*models.BlockHeader) {}
 
+// OnReorg re-checks the receipts of the transactions confirmed since the
+// reorganisation's common ancestor. Transactions no longer included in the
+// chain are marked unconfirmed and rebroadcast.
+func (txm *EthTxManager) OnReorg(reorg *models.Reorg) {
+	ancestor := reorg.CommonAncestor.ToInt().Uint64()
+	since := uint64(0)
+	if window := txm.config.HeadHistoryDepth(); ancestor > window {
+		since = ancestor - window
+	}
+
+	attempts, err := txm.orm.ConfirmedTxAttemptsSince(since)
+	if err != nil {
+		logger.Errorw("TxManager OnReorg: unable to load confirmed transactions", "err", err)
+		return
+	}
+
+	for _, txat := range attempts {
+		if err := txm.recheckConfirmedAttempt(txat); err != nil {
+			logger.Errorw(
+				fmt.Sprintf("TxManager OnReorg: unable to re-check tx attempt %s", txat.Hash.Hex()),
+				"txHash", txat.Hash.String(),
+				"err", err,
+			)
+		}
+	}
+}
+
+func (txm *EthTxManager) recheckConfirmedAttempt(txat models.TxAttempt) error {
+	receipt, err := txm.GetTxReceipt(txat.Hash)
+	if err != nil {
+		return err
+	}
+	if !receipt.Unconfirmed() {
+		return nil
+	}
+
+	tx, err := txm.orm.FindTx(txat.TxID)
+	if err != nil {
+		return err
+	}
+	if err := txm.orm.UnconfirmTx(tx, &txat); err != nil {
+		return err
+	}
+
+	logger.Warnw(
+		fmt.Sprintf("Tx %s was orphaned by a chain reorganisation, rebroadcasting", txat.Hash.Hex()),
+		"txHash", txat.Hash.String(),
+		"txid", txat.TxID,
+		"from", tx.From.Hex(),
+	)
+	if _, err := txm.SendRawTx(txat.Hex); err != nil {
+		logger.Warnw("TxManager OnReorg: rebroadcast failed", "txHash", txat.Hash.String(), "err", err)
+	}
+	return nil
+}
+
 // CreateTx signs and sends a transaction to the Ethereum blockchain.
 func (txm *EthTxManager) CreateTx(to common.Address, data []byte) (*models.Tx, error) {
-	return txm.CreateTxWithGas(to, data, txm.config.EthGasPriceDefault(), DefaultGasLimit)
+	return txm.CreateTxWithGas(to, data, txm.gasPriceEstimator.GasPrice(), DefaultGasLimit, 0)
 }
 
 // CreateTxWithGas signs and sends a transaction to the Ethereum blockchain.
-func (txm *EthTxManager) CreateTxWithGas(to common.Address, data []byte, gasPriceWei *big.Int, gasLimit uint64) (*models.Tx, error) {
+// Unless a maxGasLimit of 0 is given, the transaction's gas limit will not
+// exceed it.
+func (txm *EthTxManager) CreateTxWithGas(to common.Address, data []byte, gasPriceWei *big.Int, gasLimit uint64, maxGasLimit uint64) (*models.Tx, error) {
 	ma, err := txm.nextAccount()
 	if err != nil {
 		return nil, err
 	}
 
-	gasPriceWei, gasLimit = normalize(gasPriceWei, gasLimit, txm.config)
+	estimate := txm.config.EthGasLimitEstimation() && !(txm.config.Dev() && gasLimit != 0)
+	gasPriceWei, gasLimit = normalize(gasPriceWei, gasLimit, txm.gasPriceEstimator.GasPrice(), txm.config)
+	gasLimit, err = txm.preflight(ma.Address, to, data, gasLimit, maxGasLimit, estimate)
+	if err != nil {
+		return nil, err
+	}
 	return txm.createTxWithNonceReload(ma, to, data, gasPriceWei, gasLimit, 0)
 }
 
+// preflight executes the transaction with eth_call when ETH_TX_PREFLIGHT is
+// enabled, returning a RevertError instead of the gas limit to send it with
+// if it would revert. When estimate is true the gas limit is estimated with
+// eth_estimateGas and multiplied by ETH_GAS_LIMIT_MULTIPLIER.
+func (txm *EthTxManager) preflight(
+	from common.Address,
+	to common.Address,
+	data []byte,
+	gasLimit uint64,
+	maxGasLimit uint64,
+	estimate bool,
+) (uint64, error) {
+	var emptyResult bool
+	if txm.config.EthTxPreflight() {
+		result, err := txm.CallContract(from, to, data)
+		if err = checkRevert(result, err); err != nil {
+			return 0, err
+		}
+		emptyResult = len(result) == 0
+	}
+
+	// eth_call returns no data for a bare revert() as well as for calls
+	// returning nothing, but eth_estimateGas fails for the former.
+	if estimate || emptyResult {
+		estimated, err := txm.EstimateGas(from, to, data)
+		if err = checkRevert(nil, err); err != nil {
+			return 0, err
+		}
+		if estimate && maxGasLimit != 0 && estimated > maxGasLimit {
+			return 0, fmt.Errorf("estimated gas limit %v exceeds the maximum of %v", estimated, maxGasLimit)
+		} else if estimate {
+			gasLimit = uint64(float64(estimated) * txm.config.EthGasLimitMultiplier())
+		}
+	}
+
+	if maxGasLimit != 0 && gasLimit > maxGasLimit {
+		gasLimit = maxGasLimit
+	}
+	return gasLimit, nil
+}
+
+// revertRegex matches the errors nodes respond with when a call reverts, as
+// opposed to errors such as a missing block or running out of gas, capturing
+// the reason if there is one. Older versions of geth fail eth_estimateGas for
+// a reverting call with the "always failing transaction" error.
+var revertRegex = regexp.MustCompile(`(?i)^(?:(?:execution )?reverted(?::\s*(.*))?|gas required exceeds allowance or always failing transaction)`)
+
+// checkRevert returns a RevertError if the result of a call, or the error the
+// node responded with, shows that the call reverted. Any other error is
+// returned as is.
+func checkRevert(result []byte, err error) error {
+	if rpcErr, ok := err.(rpc.Error); ok {
+		if match := revertRegex.FindStringSubmatch(rpcErr.Error()); match != nil {
+			return &RevertError{Reason: match[1]}
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	if reason, ok := utils.ExtractRevertReason(result); ok {
+		return &RevertError{Reason: reason}
+	}
+	return nil
+}
+
 // CreateTxWithEth signs and sends a transaction with some ETH to transfer.
 func (txm *EthTxManager) CreateTxWithEth(to common.Address, value *assets.Eth) (*models.Tx, error) {
 	ma, err := txm.nextAccount()
@@ -146,7 +458,7 @@ func (txm *EthTxManager) CreateTxWithEth(to common.Address, value *assets.Eth) (
 		return nil, err
 	}
 
-	return txm.createEthTxWithNonceReload(ma, to, []byte{}, txm.config.EthGasPriceDefault(), DefaultGasLimit, value, 0)
+	return txm.createEthTxWithNonceReload(ma, to, []byte{}, txm.gasPriceEstimator.GasPrice(), DefaultGasLimit, value, 0)
 }
 
 func (txm *EthTxManager) nextAccount() (*ManagedAccount, error) {
@@ -155,20 +467,22 @@ func (txm *EthTxManager) nextAccount() (*ManagedAccount, error) {
 	}
 
 	ma := txm.NextActiveAccount()
-	if ma == nil {
-		return nil, errors.New("Must activate an account before creating a transaction")
+	if ma == nil && txm.lowBalanceAccounts() > 0 {
+		return nil, ErrLowBalance
+	} else if ma == nil {
+		return nil, errors.New("Must activate an account with sending enabled before creating a transaction")
 	}
 
 	return ma, nil
 }
 
-func normalize(gasPriceWei *big.Int, gasLimit uint64, config Config) (*big.Int, uint64) {
+func normalize(gasPriceWei *big.Int, gasLimit uint64, estimate *big.Int, config Config) (*big.Int, uint64) {
 	if !config.Dev() {
-		return config.EthGasPriceDefault(), DefaultGasLimit
+		return estimate, DefaultGasLimit
 	}
 
 	if gasPriceWei == nil {
-		gasPriceWei = config.EthGasPriceDefault()
+		gasPriceWei = estimate
 	}
 
 	if gasLimit == 0 {
@@ -271,9 +585,17 @@ func (txm *EthTxManager) GetLINKBalance(address common.Address) (*assets.Link, e
 	return (*assets.Link)(balance), nil
 }
 
-// BumpGasUntilSafe returns true if the given transaction hash has been
-// confirmed on the blockchain.
+// BumpGasUntilSafe returns the receipt of the given transaction hash once it
+// has been confirmed on the blockchain. If the transaction reverted, its
+// receipt is returned along with a RevertError, and if it was replaced
+// outside of the node ErrTxReplaced is returned.
 func (txm *EthTxManager) BumpGasUntilSafe(hash common.Hash) (*TxReceipt, error) {
+	unlock, err := txm.lockTxWithHash(hash)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
 	blkNum, err := txm.getBlockNumber()
 	if err != nil {
 		return nil, err
@@ -282,18 +604,45 @@ func (txm *EthTxManager) BumpGasUntilSafe(hash common.Hash) (*TxReceipt, error)
 	if err != nil {
 		return nil, err
 	}
+	if tx.Replaced {
+		return nil, ErrTxReplaced
+	}
 
 	var merr error
 	for _, txat := range attempts {
 		receipt, err := txm.checkAttempt(tx, &txat, blkNum)
+		if receipt != nil && receipt.Reverted() {
+			return receipt, err
+		}
 		merr = multierr.Append(merr, err)
-		if receipt != nil {
+		if receipt != nil && txat.Cancels {
+			return receipt, ErrTxCancelled
+		} else if receipt != nil {
 			return receipt, merr
 		}
 	}
 	return nil, merr
 }
 
+// lockTx locks the transaction with the given ID, so that its attempts are
+// checked, bumped and replaced one at a time, returning the function to
+// unlock it.
+func (txm *EthTxManager) lockTx(id uint64) func() {
+	lock := &txm.txLocks[id%txLockStripes]
+	lock.Lock()
+	return lock.Unlock
+}
+
+// lockTxWithHash locks the transaction with the given hash, or the hash of
+// any of its attempts.
+func (txm *EthTxManager) lockTxWithHash(hash common.Hash) (func(), error) {
+	txat, err := txm.orm.FindTxAttempt(hash)
+	if err != nil {
+		return nil, err
+	}
+	return txm.lockTx(txat.TxID), nil
+}
+
 func (txm *EthTxManager) getTxAndAttempts(hash common.Hash) (*models.Tx, []models.TxAttempt, error) {
 	attempt, err := txm.orm.FindTxAttempt(hash)
 	if err != nil {
@@ -369,12 +718,7 @@ func (txm *EthTxManager) createAttempt(
 	gasPriceWei *big.Int,
 	blkNum uint64,
 ) (*models.TxAttempt, error) {
-	ma := txm.getAccount(tx.From)
-	if ma == nil {
-		return nil, fmt.Errorf("Unable to locate %v as an available account in EthTxManager. Has TxManager been started or has the address been removed?", tx.From.Hex())
-	}
-	etx := tx.EthTx(gasPriceWei)
-	etx, err := txm.keyStore.SignTx(ma.Account, etx, txm.config.ChainID())
+	etx, err := txm.signTx(tx, gasPriceWei)
 	if err != nil {
 		return nil, err
 	}
@@ -386,6 +730,14 @@ func (txm *EthTxManager) createAttempt(
 	return a, txm.sendTransaction(etx)
 }
 
+func (txm *EthTxManager) signTx(tx *models.Tx, gasPriceWei *big.Int) (*types.Transaction, error) {
+	ma := txm.getAccount(tx.From)
+	if ma == nil {
+		return nil, fmt.Errorf("Unable to locate %v as an available account in EthTxManager. Has TxManager been started or has the address been removed?", tx.From.Hex())
+	}
+	return txm.keyStore.SignTx(ma.Account, tx.EthTx(gasPriceWei), txm.config.ChainID())
+}
+
 func (txm *EthTxManager) sendTransaction(tx *types.Transaction) error {
 	hex, err := utils.EncodeTxToHex(tx)
 	if err != nil {
@@ -466,9 +818,27 @@ func (txm *EthTxManager) handleConfirmed(
 		"err", balanceErr,
 	)
 
+	if rcpt.Reverted() {
+		err := txm.revertError(tx, rcpt)
+		logger.Warnw(fmt.Sprintf("Confirmed tx %v reverted", txat.Hash.String()), "txHash", txat.Hash.String(), "err", err)
+		return rcpt, err
+	}
 	return rcpt, nil
 }
 
+// revertError replays a reverted transaction with eth_call at the block it
+// was mined in, returning a RevertError with the reason it reverted with.
+func (txm *EthTxManager) revertError(tx *models.Tx, rcpt *TxReceipt) error {
+	result, err := txm.ReplayTx(tx, rcpt.BlockNumber.ToBig())
+	err = checkRevert(result, err)
+	if revertErr, ok := err.(*RevertError); ok {
+		return revertErr
+	} else if err != nil {
+		logger.Warnw("Unable to replay reverted transaction", "txHash", rcpt.Hash.String(), "err", err)
+	}
+	return &RevertError{}
+}
+
 func (txm *EthTxManager) handleUnconfirmed(
 	tx *models.Tx,
 	txat *models.TxAttempt,
@@ -484,41 +854,154 @@ func (txm *EthTxManager) handleUnconfirmed(
 	bumpable := tx.Hash == txat.Hash
 	pastThreshold := blkNum >= txat.SentAt+txm.config.EthGasBumpThreshold()
 	if bumpable && pastThreshold {
-		return nil, txm.bumpGas(txat, blkNum)
+		gasPrice := txm.gasPriceEstimator.BumpedGasPrice(txat.GasPrice)
+		if gasPrice.Cmp(txat.GasPrice) <= 0 {
+			logger.Warnw(fmt.Sprintf("Not bumping gas for transaction %v, already at the maximum gas price", txat.Hash.String()), "txat", txat)
+			return nil, nil
+		}
+		_, err := txm.bumpGas(txat, gasPrice, blkNum)
+		return nil, err
 	}
 	return nil, nil
 }
 
-func (txm *EthTxManager) bumpGas(txat *models.TxAttempt, blkNum uint64) error {
+func (txm *EthTxManager) bumpGas(txat *models.TxAttempt, gasPrice *big.Int, blkNum uint64) (*models.TxAttempt, error) {
 	tx, err := txm.orm.FindTx(txat.TxID)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	gasPrice := new(big.Int).Add(txat.GasPrice, txm.config.EthGasBumpWei())
 	bumpedTxAt, err := txm.createAttempt(tx, gasPrice, blkNum)
 	if err != nil {
-		return err
+		return bumpedTxAt, err
 	}
 	logger.Infow(fmt.Sprintf("Bumping gas to %v for transaction %v", gasPrice, bumpedTxAt.Hash.String()), "txat", bumpedTxAt)
-	return nil
+	return bumpedTxAt, nil
+}
+
+// SpeedUpTx replaces the transaction with the given hash, or the hash of any
+// of its attempts, with a new attempt at the given gas price.
+func (txm *EthTxManager) SpeedUpTx(hash common.Hash, gasPrice *big.Int) (*models.Tx, error) {
+	unlock, err := txm.lockTxWithHash(hash)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	tx, err := txm.replaceableTx(hash, gasPrice)
+	if err != nil {
+		return nil, err
+	}
+	blkNum, err := txm.getBlockNumber()
+	if err != nil {
+		return nil, err
+	}
+	txat, err := txm.bumpGas(&tx.TxAttempt, gasPrice, blkNum)
+	return txm.replaced(tx, txat, err)
+}
+
+// CancelTx replaces the transaction with the given hash, or the hash of any
+// of its attempts, with a zero value transaction to its sender with the same
+// nonce. It is sent at the given gas price, or at a bumped gas price if nil.
+// Waiting on the transaction returns ErrTxCancelled once the cancellation is
+// confirmed.
+func (txm *EthTxManager) CancelTx(hash common.Hash, gasPrice *big.Int) (*models.Tx, error) {
+	unlock, err := txm.lockTxWithHash(hash)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	tx, err := txm.replaceableTx(hash, gasPrice)
+	if err != nil {
+		return nil, err
+	}
+	if gasPrice == nil {
+		gasPrice = txm.gasPriceEstimator.BumpedGasPrice(tx.GasPrice)
+		if gasPrice.Cmp(tx.GasPrice) <= 0 {
+			return nil, &ReplacementUnderpricedError{GasPrice: gasPrice, Current: tx.GasPrice}
+		}
+	}
+	blkNum, err := txm.getBlockNumber()
+	if err != nil {
+		return nil, err
+	}
+
+	cancellation := *tx
+	cancellation.To = tx.From
+	cancellation.Data = []byte{}
+	cancellation.Value = big.NewInt(0)
+	cancellation.GasLimit = selfSendGasLimit
+	cancellation.Cancels = true
+	txat, err := txm.createAttempt(&cancellation, gasPrice, blkNum)
+	return txm.replaced(tx, txat, err)
+}
+
+// replaceableTx returns the unconfirmed transaction with the given hash, or
+// the hash of any of its attempts, checking that the given gas price, if
+// any, is higher than that of its latest attempt.
+func (txm *EthTxManager) replaceableTx(hash common.Hash, gasPrice *big.Int) (*models.Tx, error) {
+	tx, _, err := txm.getTxAndAttempts(hash)
+	if err != nil {
+		return nil, err
+	}
+	if tx.Confirmed {
+		return nil, ErrTxConfirmed
+	}
+	if gasPrice != nil && gasPrice.Cmp(tx.GasPrice) <= 0 {
+		return nil, &ReplacementUnderpricedError{GasPrice: gasPrice, Current: tx.GasPrice}
+	}
+	return tx, nil
+}
+
+// replaced returns the transaction after replacing the previous transaction
+// with a new attempt. Unlike a gas bump, a replacement that could not be sent
+// is removed, leaving the transaction as it was.
+func (txm *EthTxManager) replaced(previous *models.Tx, txat *models.TxAttempt, err error) (*models.Tx, error) {
+	if err != nil && txat != nil {
+		return nil, multierr.Append(err, txm.orm.RemoveTxAttempt(previous, txat))
+	} else if err != nil {
+		return nil, err
+	}
+	logger.Infow(fmt.Sprintf("Replaced transaction %v with %v at gas price %v", previous.ID, txat.Hash.String(), txat.GasPrice), "txat", txat)
+	return txm.orm.FindTx(previous.ID)
 }
 
 // NextActiveAccount uses round robin to select a managed account
-// from the list of available accounts as defined in Register(...)
+// from the list of available accounts as defined in Register(...),
+// skipping those with sending disabled or an ETH balance below
+// ETH_BALANCE_THRESHOLD.
 func (txm *EthTxManager) NextActiveAccount() *ManagedAccount {
 	txm.accountsMutex.Lock()
 	defer txm.accountsMutex.Unlock()
 
-	if len(txm.availableAccounts) == 0 {
-		return nil
+	count := len(txm.availableAccounts)
+	for i := 0; i < count; i++ {
+		current := (txm.availableAccountIdx + i) % count
+		if ma := txm.availableAccounts[current]; !ma.SendDisabled() && !ma.LowBalance() {
+			txm.availableAccountIdx = (current + 1) % count
+			return ma
+		}
 	}
+	return nil
+}
 
-	current := txm.availableAccountIdx
-	txm.availableAccountIdx++
-	if txm.availableAccountIdx >= len(txm.availableAccounts) {
-		txm.availableAccountIdx = 0
+// lowBalanceAccounts returns the number of accounts with sending enabled
+// which are out of the rotation for having a low ETH balance.
+func (txm *EthTxManager) lowBalanceAccounts() int {
+	count := 0
+	for _, ma := range txm.activeAccounts() {
+		if !ma.SendDisabled() && ma.LowBalance() {
+			count++
+		}
 	}
-	return txm.availableAccounts[current]
+	return count
+}
+
+func (txm *EthTxManager) activeAccounts() []*ManagedAccount {
+	txm.accountsMutex.Lock()
+	defer txm.accountsMutex.Unlock()
+
+	return append([]*ManagedAccount{}, txm.availableAccounts...)
 }
 
 func (txm *EthTxManager) getAccount(from common.Address) *ManagedAccount {
@@ -542,21 +1025,43 @@ func (txm *EthTxManager) activateAccount(account accounts.Account) (*ManagedAcco
 		return nil, err
 	}
 
-	return NewManagedAccount(account, nonce), nil
+	ma := NewManagedAccount(account, nonce)
+	ma.lowBalance.SetTo(txm.balanceMonitor.isLow(account.Address))
+	key, err := txm.orm.FindKey(account.Address)
+	if err == nil {
+		ma.sendDisabled.SetTo(key.SendDisabled)
+	} else if err != orm.ErrorNotFound {
+		return nil, err
+	}
+	return ma, nil
 }
 
 // ManagedAccount holds the account information alongside a client managed nonce
 // to coordinate outgoing transactions.
 type ManagedAccount struct {
 	accounts.Account
-	nonce uint64
-	mutex *sync.Mutex
+	nonce        uint64
+	mutex        *sync.Mutex
+	sendDisabled *abool.AtomicBool
+	lowBalance   *abool.AtomicBool
 }
 
 // NewManagedAccount creates a managed account that handles nonce increments
 // locally.
 func NewManagedAccount(a accounts.Account, nonce uint64) *ManagedAccount {
-	return &ManagedAccount{Account: a, nonce: nonce, mutex: &sync.Mutex{}}
+	return &ManagedAccount{Account: a, nonce: nonce, mutex: &sync.Mutex{}, sendDisabled: abool.New(), lowBalance: abool.New()}
+}
+
+// SendDisabled returns true if the account is kept from sending new
+// transactions.
+func (a *ManagedAccount) SendDisabled() bool {
+	return a.sendDisabled.IsSet()
+}
+
+// LowBalance returns true if the account's ETH balance was below
+// ETH_BALANCE_THRESHOLD when last checked.
+func (a *ManagedAccount) LowBalance() bool {
+	return a.lowBalance.IsSet()
 }
 
 // GetNonce returns the client side managed nonce.
@@ -568,6 +1073,10 @@ func (a *ManagedAccount) GetNonce() uint64 {
 func (a *ManagedAccount) ReloadNonce(txm *EthTxManager) error {
 	a.mutex.Lock()
 	defer a.mutex.Unlock()
+	return a.reloadNonce(txm)
+}
+
+func (a *ManagedAccount) reloadNonce(txm *EthTxManager) error {
 	nonce, err := txm.GetNonce(a.Address)
 	if err != nil {
 		return fmt.Errorf("TxManager ReloadNonce: %v", err)
@@ -10,11 +10,13 @@ import (
 
 	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/smartcontractkit/chainlink/internal/cltest"
 	"github.com/smartcontractkit/chainlink/store"
 	strpkg "github.com/smartcontractkit/chainlink/store"
 	"github.com/smartcontractkit/chainlink/store/assets"
 	"github.com/smartcontractkit/chainlink/store/models"
+	"github.com/smartcontractkit/chainlink/store/orm"
 	"github.com/smartcontractkit/chainlink/utils"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -454,15 +456,118 @@ func TestTxManager_BumpGasUntilSafe_erroring(t *testing.T) {
 	}
 }
 
+func TestTxManager_BumpGasUntilSafe_Reverted(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplicationWithKeyStore()
+	defer cleanup()
+	store := app.Store
+	txm := store.TxManager
+
+	sentAt := uint64(23456)
+	safeAt := sentAt + store.Config.MinOutgoingConfirmations()
+	tx := cltest.CreateTxAndAttempt(store, cltest.GetAccountAddress(store), sentAt)
+	status := hexutil.Uint64(0)
+	receipt := strpkg.TxReceipt{Hash: tx.Hash, BlockNumber: cltest.Int(sentAt), Status: &status}
+
+	ethMock := app.MockEthClient()
+	ethMock.Context("txm.BumpGasUntilSafe()", func(ethMock *cltest.EthMock) {
+		ethMock.Register("eth_blockNumber", utils.Uint64ToHex(safeAt))
+		ethMock.Register("eth_getTransactionReceipt", receipt)
+		ethMock.Register("eth_call", "0x0")
+		ethMock.Register("eth_getBalance", "0x0")
+		ethMock.Register("eth_call", hexutil.Bytes(hexutil.MustDecode("0x08c379a0"+
+			"0000000000000000000000000000000000000000000000000000000000000020"+
+			"000000000000000000000000000000000000000000000000000000000000000f"+
+			"4e6f7420656e6f756768204c494e4b0000000000000000000000000000000000")),
+			func(_ interface{}, data ...interface{}) error {
+				args := data[0].([]interface{})
+				assert.Equal(t, hexutil.EncodeUint64(sentAt), args[1])
+				return nil
+			})
+	})
+	require.NoError(t, app.StartAndConnect())
+
+	rcpt, err := txm.BumpGasUntilSafe(tx.Hash)
+	require.NotNil(t, rcpt)
+	assert.True(t, rcpt.Reverted())
+	assert.Equal(t, &strpkg.RevertError{Reason: "Not enough LINK"}, err)
+	ethMock.EventuallyAllCalled(t)
+}
+
+func TestTxManager_BumpGasUntilSafe_Cancelled(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplicationWithKeyStore()
+	defer cleanup()
+	store := app.Store
+	txm := store.TxManager
+
+	sentAt := uint64(23456)
+	safeAt := sentAt + store.Config.MinOutgoingConfirmations()
+	tx := cltest.CreateTxAndAttempt(store, cltest.GetAccountAddress(store), sentAt)
+	attempts, err := store.TxAttemptsFor(tx.ID)
+	require.NoError(t, err)
+	attempts[0].Cancels = true
+	require.NoError(t, store.Save(&attempts[0]))
+
+	ethMock := app.MockEthClient()
+	ethMock.Context("txm.BumpGasUntilSafe()", func(ethMock *cltest.EthMock) {
+		ethMock.Register("eth_blockNumber", utils.Uint64ToHex(safeAt))
+		ethMock.Register("eth_getTransactionReceipt", strpkg.TxReceipt{Hash: tx.Hash, BlockNumber: cltest.Int(sentAt)})
+		ethMock.Register("eth_call", "0x0")
+		ethMock.Register("eth_getBalance", "0x0")
+	})
+	require.NoError(t, app.StartAndConnect())
+
+	rcpt, err := txm.BumpGasUntilSafe(tx.Hash)
+	require.NotNil(t, rcpt)
+	assert.Equal(t, strpkg.ErrTxCancelled, err)
+	ethMock.EventuallyAllCalled(t)
+}
+
+func TestTxManager_BumpGasUntilSafe_RevertedWithoutReplay(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplicationWithKeyStore()
+	defer cleanup()
+	store := app.Store
+	txm := store.TxManager
+
+	sentAt := uint64(23456)
+	safeAt := sentAt + store.Config.MinOutgoingConfirmations()
+	tx := cltest.CreateTxAndAttempt(store, cltest.GetAccountAddress(store), sentAt)
+	status := hexutil.Uint64(0)
+	receipt := strpkg.TxReceipt{Hash: tx.Hash, BlockNumber: cltest.Int(sentAt), Status: &status}
+
+	ethMock := app.MockEthClient()
+	ethMock.Context("txm.BumpGasUntilSafe()", func(ethMock *cltest.EthMock) {
+		ethMock.Register("eth_blockNumber", utils.Uint64ToHex(safeAt))
+		ethMock.Register("eth_getTransactionReceipt", receipt)
+		ethMock.Register("eth_call", "0x0")
+		ethMock.Register("eth_getBalance", "0x0")
+		ethMock.RegisterRPCError("eth_call", -32000, "missing trie node")
+	})
+	require.NoError(t, app.StartAndConnect())
+
+	rcpt, err := txm.BumpGasUntilSafe(tx.Hash)
+	require.NotNil(t, rcpt)
+	assert.True(t, rcpt.Reverted())
+	assert.Equal(t, &strpkg.RevertError{}, err)
+	ethMock.EventuallyAllCalled(t)
+}
+
 func TestTxManager_Register(t *testing.T) {
 	t.Parallel()
 
+	s, cleanup := cltest.NewStore()
+	defer cleanup()
 	ethMock := &cltest.EthMock{}
 	txm := store.NewEthTxManager(
 		&strpkg.EthClient{CallerSubscriber: ethMock},
 		store.NewConfig(),
 		nil,
-		nil,
+		s.ORM,
 	)
 
 	ethMock.Register("eth_getTransactionCount", `0x2D0`)
@@ -479,12 +584,14 @@ func TestTxManager_Register(t *testing.T) {
 func TestTxManager_NextActiveAccount_RoundRobin(t *testing.T) {
 	t.Parallel()
 
+	s, cleanup := cltest.NewStore()
+	defer cleanup()
 	ethMock := &cltest.EthMock{}
 	txm := store.NewEthTxManager(
 		&strpkg.EthClient{CallerSubscriber: ethMock},
 		store.NewConfig(),
 		nil,
-		nil,
+		s.ORM,
 	)
 
 	accounts := []accounts.Account{
@@ -511,6 +618,53 @@ func TestTxManager_NextActiveAccount_RoundRobin(t *testing.T) {
 	assert.Equal(t, a0, a2)
 }
 
+func TestTxManager_NextActiveAccount_SkipsSendDisabled(t *testing.T) {
+	t.Parallel()
+
+	s, cleanup := cltest.NewStore()
+	defer cleanup()
+	ethMock := &cltest.EthMock{}
+	txm := store.NewEthTxManager(
+		&strpkg.EthClient{CallerSubscriber: ethMock},
+		store.NewConfig(),
+		nil,
+		s.ORM,
+	)
+
+	accounts := []accounts.Account{
+		accounts.Account{Address: common.HexToAddress("0xbf4ed7b27f1d666546e30d74d50d173d20bca001")},
+		accounts.Account{Address: common.HexToAddress("0xbf4ed7b27f1d666546e30d74d50d173d20bca002")},
+		accounts.Account{Address: common.HexToAddress("0xbf4ed7b27f1d666546e30d74d50d173d20bca003")},
+	}
+	require.NoError(t, s.SaveKey(&models.Key{Address: accounts[1].Address, SendDisabled: true}))
+
+	ethMock.Register("eth_getTransactionCount", `0x1`)
+	ethMock.Register("eth_getTransactionCount", `0x1`)
+	txm.Register(accounts[:2])
+	require.NoError(t, txm.Connect(cltest.IndexableBlockNumber(1)))
+
+	assert.Equal(t, accounts[0].Address, txm.NextActiveAccount().Address)
+	assert.Equal(t, accounts[0].Address, txm.NextActiveAccount().Address)
+
+	ethMock.Register("eth_getTransactionCount", `0x1`)
+	txm.AddAccount(accounts[2])
+	ethMock.EventuallyAllCalled(t)
+	assert.Equal(t, accounts[2].Address, txm.NextActiveAccount().Address)
+	assert.Equal(t, accounts[0].Address, txm.NextActiveAccount().Address)
+
+	require.NoError(t, txm.SetSendDisabled(accounts[1].Address, false))
+	assert.Equal(t, accounts[1].Address, txm.NextActiveAccount().Address)
+
+	require.NoError(t, txm.SetSendDisabled(accounts[0].Address, true))
+	require.NoError(t, txm.SetSendDisabled(accounts[1].Address, true))
+	require.NoError(t, txm.SetSendDisabled(accounts[2].Address, true))
+	assert.Nil(t, txm.NextActiveAccount())
+
+	key, err := s.FindKey(accounts[2].Address)
+	require.NoError(t, err)
+	assert.True(t, key.SendDisabled)
+}
+
 func TestTxManager_ReloadNonce(t *testing.T) {
 	t.Parallel()
 
@@ -737,7 +891,7 @@ func TestTxManager_CreateTxWithGas(t *testing.T) {
 				ethMock.Register("eth_blockNumber", utils.Uint64ToHex(1))
 			})
 
-			tx, err := manager.CreateTxWithGas(to, data, test.gasPrice, test.gasLimit)
+			tx, err := manager.CreateTxWithGas(to, data, test.gasPrice, test.gasLimit, 0)
 			assert.NoError(t, err)
 			assert.Equal(t, test.expectedGasLimit, tx.GasLimit)
 
@@ -750,3 +904,326 @@ func TestTxManager_CreateTxWithGas(t *testing.T) {
 		})
 	}
 }
+
+func TestTxManager_CreateTxWithGas_Preflight(t *testing.T) {
+	t.Parallel()
+
+	config, configCleanup := cltest.NewConfig()
+	defer configCleanup()
+	config.Set("ETH_TX_PREFLIGHT", true)
+	config.Set("ETH_GAS_LIMIT_ESTIMATION", true)
+	config.Set("ETH_GAS_LIMIT_MULTIPLIER", 1.5)
+	app, cleanup := cltest.NewApplicationWithConfigAndKeyStore(config)
+	defer cleanup()
+	store := app.Store
+	manager := store.TxManager
+
+	ethMock := app.MockEthClient()
+	ethMock.Context("app.Start()", func(ethMock *cltest.EthMock) {
+		ethMock.Register("eth_getTransactionCount", utils.Uint64ToHex(0))
+	})
+	require.NoError(t, app.StartAndConnect())
+
+	to := cltest.NewAddress()
+	reverted := hexutil.MustDecode("0x08c379a0" +
+		"0000000000000000000000000000000000000000000000000000000000000020" +
+		"000000000000000000000000000000000000000000000000000000000000000f" +
+		"4e6f7420656e6f756768204c494e4b0000000000000000000000000000000000")
+
+	tests := []struct {
+		name             string
+		callResult       hexutil.Bytes
+		callError        string
+		estimate         uint64
+		estimateError    string
+		maxGasLimit      uint64
+		expectedGasLimit uint64
+		expectedError    string
+		expectRevert     bool
+	}{
+		{"estimated", hexutil.Bytes{}, "", 100000, "", 0, 150000, "", false},
+		{"capped", hexutil.Bytes{}, "", 100000, "", 120000, 120000, "", false},
+		{"estimate over cap", hexutil.Bytes{}, "", 100000, "", 90000, 0, "estimated gas limit 100000 exceeds the maximum of 90000", false},
+		{"would revert", reverted, "", 0, "", 0, 0, "transaction reverted: Not enough LINK", true},
+		{"node reports revert", nil, "execution reverted: Not enough LINK", 0, "", 0, 0, "transaction reverted: Not enough LINK", true},
+		{"node reports revert without execution", nil, "reverted: Not enough LINK", 0, "", 0, 0, "transaction reverted: Not enough LINK", true},
+		{"node reports bare revert", nil, "execution reverted", 0, "", 0, 0, "transaction reverted", true},
+		{"bare revert", hexutil.Bytes{}, "", 0, "execution reverted", 0, 0, "transaction reverted", true},
+		{"always failing estimate", hexutil.Bytes{}, "", 0, "gas required exceeds allowance or always failing transaction", 0, 0, "transaction reverted", true},
+		{"node error", nil, "header not found", 0, "", 0, 0, "header not found", false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ethMock.Context("manager.CreateTxWithGas", func(ethMock *cltest.EthMock) {
+				if test.callError != "" {
+					ethMock.RegisterRPCError("eth_call", -32000, test.callError)
+				} else {
+					ethMock.Register("eth_call", test.callResult)
+				}
+				if test.estimateError != "" {
+					ethMock.RegisterRPCError("eth_estimateGas", -32000, test.estimateError)
+				} else if test.estimate != 0 {
+					ethMock.Register("eth_estimateGas", hexutil.Uint64(test.estimate))
+				}
+				if test.expectedError == "" {
+					ethMock.Register("eth_blockNumber", utils.Uint64ToHex(1))
+					ethMock.Register("eth_sendRawTransaction", cltest.NewHash())
+				}
+			})
+
+			tx, err := manager.CreateTxWithGas(to, []byte{}, nil, 0, test.maxGasLimit)
+			if test.expectedError != "" {
+				require.Error(t, err)
+				assert.Equal(t, test.expectedError, err.Error())
+				_, reverted := err.(*strpkg.RevertError)
+				assert.Equal(t, test.expectRevert, reverted)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, test.expectedGasLimit, tx.GasLimit)
+			}
+			ethMock.EventuallyAllCalled(t)
+		})
+	}
+}
+
+func TestTxManager_CreateTxWithGas_PreflightWithoutEstimation(t *testing.T) {
+	t.Parallel()
+
+	config, configCleanup := cltest.NewConfig()
+	defer configCleanup()
+	config.Set("ETH_TX_PREFLIGHT", true)
+	app, cleanup := cltest.NewApplicationWithConfigAndKeyStore(config)
+	defer cleanup()
+	manager := app.Store.TxManager
+
+	ethMock := app.MockEthClient()
+	ethMock.Context("app.Start()", func(ethMock *cltest.EthMock) {
+		ethMock.Register("eth_getTransactionCount", utils.Uint64ToHex(0))
+	})
+	require.NoError(t, app.StartAndConnect())
+
+	to := cltest.NewAddress()
+	ethMock.Context("manager.CreateTxWithGas", func(ethMock *cltest.EthMock) {
+		ethMock.Register("eth_call", hexutil.Bytes{})
+		ethMock.RegisterRPCError("eth_estimateGas", -32000, "gas required exceeds allowance or always failing transaction")
+	})
+	_, err := manager.CreateTxWithGas(to, []byte{}, nil, 50000, 0)
+	assert.Equal(t, &strpkg.RevertError{}, err, "a bare revert() is caught by estimating the gas")
+	ethMock.EventuallyAllCalled(t)
+
+	ethMock.Context("manager.CreateTxWithGas", func(ethMock *cltest.EthMock) {
+		ethMock.Register("eth_call", hexutil.Bytes{})
+		ethMock.Register("eth_estimateGas", hexutil.Uint64(21000))
+		ethMock.Register("eth_blockNumber", utils.Uint64ToHex(1))
+		ethMock.Register("eth_sendRawTransaction", cltest.NewHash())
+	})
+	tx, err := manager.CreateTxWithGas(to, []byte{}, nil, 50000, 0)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(50000), tx.GasLimit, "the estimate is only used to check for a revert")
+	ethMock.EventuallyAllCalled(t)
+}
+
+func TestTxManager_OnReorg_UnconfirmsOrphanedTxs(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+	ethMock := cltest.MockEthOnStore(store)
+
+	tx := cltest.CreateTxAndAttempt(store, cltest.NewAddress(), 10)
+	attempts, err := store.TxAttemptsFor(tx.ID)
+	require.NoError(t, err)
+	require.NoError(t, store.ConfirmTx(tx, &attempts[0]))
+
+	ethMock.Register("eth_getTransactionReceipt", strpkg.TxReceipt{})
+	ethMock.Register("eth_sendRawTransaction", attempts[0].Hash)
+
+	store.TxManager.OnReorg(&models.Reorg{
+		CommonAncestor: cltest.IndexableBlockNumber(8),
+		Depth:          3,
+	})
+
+	assert.True(t, ethMock.AllCalled())
+	tx, err = store.FindTx(tx.ID)
+	require.NoError(t, err)
+	assert.False(t, tx.Confirmed)
+	confirmed, err := store.ConfirmedTxAttemptsSince(0)
+	require.NoError(t, err)
+	assert.Len(t, confirmed, 0)
+}
+
+func TestTxManager_OnReorg_KeepsIncludedTxs(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+	ethMock := cltest.MockEthOnStore(store)
+
+	tx := cltest.CreateTxAndAttempt(store, cltest.NewAddress(), 10)
+	attempts, err := store.TxAttemptsFor(tx.ID)
+	require.NoError(t, err)
+	require.NoError(t, store.ConfirmTx(tx, &attempts[0]))
+
+	ethMock.Register("eth_getTransactionReceipt", strpkg.TxReceipt{
+		Hash:        attempts[0].Hash,
+		BlockNumber: cltest.Int(11),
+	})
+
+	store.TxManager.OnReorg(&models.Reorg{
+		CommonAncestor: cltest.IndexableBlockNumber(8),
+		Depth:          3,
+	})
+
+	assert.True(t, ethMock.AllCalled())
+	tx, err = store.FindTx(tx.ID)
+	require.NoError(t, err)
+	assert.True(t, tx.Confirmed)
+}
+
+func TestTxManager_SpeedUpTx(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplicationWithKeyStore()
+	defer cleanup()
+	store := app.Store
+	from := cltest.GetAccountAddress(store)
+
+	ethMock := app.MockEthClient()
+	ethMock.Context("app.Start()", func(ethMock *cltest.EthMock) {
+		ethMock.Register("eth_getTransactionCount", utils.Uint64ToHex(0))
+	})
+	require.NoError(t, app.StartAndConnect())
+
+	tx := cltest.CreateTxAndAttempt(store, from, 10)
+	gasPrice := big.NewInt(50)
+	ethMock.Register("eth_blockNumber", utils.Uint64ToHex(12))
+	ethMock.Register("eth_sendRawTransaction", cltest.NewHash(),
+		func(_ interface{}, data ...interface{}) error {
+			rlp := data[0].([]interface{})[0].(string)
+			etx, err := utils.DecodeEthereumTx(rlp)
+			require.NoError(t, err)
+			assert.Equal(t, tx.Nonce, etx.Nonce())
+			assert.Equal(t, tx.To, *etx.To())
+			assert.Equal(t, gasPrice, etx.GasPrice())
+			return nil
+		})
+
+	sped, err := store.TxManager.SpeedUpTx(tx.Hash, gasPrice)
+	require.NoError(t, err)
+	assert.Equal(t, gasPrice, sped.GasPrice)
+	assert.Equal(t, uint64(12), sped.SentAt)
+	assert.NotEqual(t, tx.Hash, sped.Hash)
+
+	attempts, err := store.TxAttemptsFor(tx.ID)
+	require.NoError(t, err)
+	assert.Len(t, attempts, 2)
+	ethMock.EventuallyAllCalled(t)
+}
+
+func TestTxManager_CancelTx(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplicationWithKeyStore()
+	defer cleanup()
+	store := app.Store
+	from := cltest.GetAccountAddress(store)
+
+	ethMock := app.MockEthClient()
+	ethMock.Context("app.Start()", func(ethMock *cltest.EthMock) {
+		ethMock.Register("eth_getTransactionCount", utils.Uint64ToHex(0))
+	})
+	require.NoError(t, app.StartAndConnect())
+
+	tx := cltest.CreateTxAndAttempt(store, from, 10)
+	tx.To = cltest.NewAddress()
+	tx.Value = big.NewInt(1000)
+	require.NoError(t, store.SaveTx(tx))
+	bumped := store.GasPriceEstimator.BumpedGasPrice(tx.GasPrice)
+
+	ethMock.Register("eth_blockNumber", utils.Uint64ToHex(12))
+	ethMock.Register("eth_sendRawTransaction", cltest.NewHash(),
+		func(_ interface{}, data ...interface{}) error {
+			rlp := data[0].([]interface{})[0].(string)
+			etx, err := utils.DecodeEthereumTx(rlp)
+			require.NoError(t, err)
+			assert.Equal(t, tx.Nonce, etx.Nonce())
+			assert.Equal(t, from, *etx.To())
+			assert.Equal(t, int64(0), etx.Value().Int64())
+			assert.Equal(t, bumped, etx.GasPrice())
+			return nil
+		})
+
+	cancelled, err := store.TxManager.CancelTx(tx.Hash, nil)
+	require.NoError(t, err)
+	assert.Equal(t, from, cancelled.To)
+	assert.Equal(t, int64(0), cancelled.Value.Int64())
+	assert.Equal(t, bumped, cancelled.GasPrice)
+	assert.True(t, cancelled.Cancels)
+	ethMock.EventuallyAllCalled(t)
+
+	txat, err := store.FindTxAttempt(cancelled.Hash)
+	require.NoError(t, err)
+	assert.True(t, txat.Cancels)
+	original, err := store.FindTxAttempt(tx.Hash)
+	require.NoError(t, err)
+	assert.False(t, original.Cancels)
+}
+
+func TestTxManager_CancelTx_Rejected(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplicationWithKeyStore()
+	defer cleanup()
+	store := app.Store
+	from := cltest.GetAccountAddress(store)
+
+	ethMock := app.MockEthClient()
+	ethMock.Context("app.Start()", func(ethMock *cltest.EthMock) {
+		ethMock.Register("eth_getTransactionCount", utils.Uint64ToHex(0))
+	})
+	require.NoError(t, app.StartAndConnect())
+
+	tx := cltest.CreateTxAndAttempt(store, from, 10)
+	to := cltest.NewAddress()
+	tx.To = to
+	tx.Value = big.NewInt(1000)
+	require.NoError(t, store.SaveTx(tx))
+
+	ethMock.Register("eth_blockNumber", utils.Uint64ToHex(12))
+	ethMock.RegisterRPCError("eth_sendRawTransaction", -32000, "replacement transaction underpriced")
+
+	_, err := store.TxManager.CancelTx(tx.Hash, nil)
+	require.Error(t, err)
+	ethMock.EventuallyAllCalled(t)
+
+	tx, err = store.FindTx(tx.ID)
+	require.NoError(t, err)
+	assert.Equal(t, to, tx.To)
+	assert.Equal(t, int64(1000), tx.Value.Int64())
+	attempts, err := store.TxAttemptsFor(tx.ID)
+	require.NoError(t, err)
+	assert.Len(t, attempts, 1)
+}
+
+func TestTxManager_ReplaceTx_Errors(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	unconfirmed := cltest.CreateTxAndAttempt(store, cltest.NewAddress(), 10)
+	confirmed := cltest.CreateTxAndAttempt(store, cltest.NewAddress(), 10)
+	attempts, err := store.TxAttemptsFor(confirmed.ID)
+	require.NoError(t, err)
+	require.NoError(t, store.ConfirmTx(confirmed, &attempts[0]))
+
+	_, err = store.TxManager.SpeedUpTx(cltest.NewHash(), big.NewInt(50))
+	assert.Equal(t, orm.ErrorNotFound, err)
+	_, err = store.TxManager.SpeedUpTx(confirmed.Hash, big.NewInt(50))
+	assert.Equal(t, strpkg.ErrTxConfirmed, err)
+	_, err = store.TxManager.CancelTx(confirmed.Hash, nil)
+	assert.Equal(t, strpkg.ErrTxConfirmed, err)
+	_, err = store.TxManager.SpeedUpTx(unconfirmed.Hash, big.NewInt(1))
+	assert.Equal(t, &strpkg.ReplacementUnderpricedError{GasPrice: big.NewInt(1), Current: big.NewInt(1)}, err)
+}
@@ -0,0 +1,342 @@
+package store
+
+import (
+	"fmt"
+	"math/big"
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/smartcontractkit/chainlink/logger"
+	"github.com/smartcontractkit/chainlink/store/models"
+	"github.com/tevino/abool"
+	"go.uber.org/multierr"
+)
+
+// maxTxReconciliations is the number of recent actions the reconciler keeps
+// to report.
+const maxTxReconciliations = 100
+
+var knownTxRegex = regexp.MustCompile("(?i)known transaction|already known")
+
+// TxReconciler is a HeadTrackable which brings the node's transactions back
+// in line with the chain on connecting, and every ETH_TX_RECONCILE_PERIOD
+// after, so that transactions left unconfirmed by a crash are not only
+// revisited when a run resumes them.
+type TxReconciler struct {
+	txm             *EthTxManager
+	running         *abool.AtomicBool
+	mutex           sync.Mutex
+	lastReconciled  time.Time
+	reconciliations []models.TxReconciliation
+	nextID          uint64
+}
+
+func newTxReconciler(txm *EthTxManager) *TxReconciler {
+	return &TxReconciler{
+		txm:     txm,
+		running: abool.New(),
+	}
+}
+
+// Connect reconciles the node's transactions in the background.
+func (tr *TxReconciler) Connect(*models.IndexableBlockNumber) error {
+	tr.reconcileInBackground()
+	return nil
+}
+
+// Disconnect does nothing; exists to comply with interface.
+func (tr *TxReconciler) Disconnect() {}
+
+// OnNewHead reconciles the node's transactions in the background if
+// ETH_TX_RECONCILE_PERIOD has passed since they last were.
+func (tr *TxReconciler) OnNewHead(*models.BlockHeader) {
+	tr.mutex.Lock()
+	due := time.Since(tr.lastReconciled) >= tr.txm.config.EthTxReconcilePeriod()
+	tr.mutex.Unlock()
+	if due {
+		tr.reconcileInBackground()
+	}
+}
+
+// OnReorg does nothing, since the transaction manager unconfirms the
+// transactions orphaned by a reorganisation.
+func (tr *TxReconciler) OnReorg(*models.Reorg) {}
+
+func (tr *TxReconciler) reconcileInBackground() {
+	if tr.txm.config.EthTxReconcilePeriod() == 0 || !tr.running.SetToIf(false, true) {
+		return
+	}
+	go func() {
+		defer tr.running.UnSet()
+		if _, err := tr.Reconcile(); err != nil {
+			logger.Warnw("Unable to reconcile transactions", "err", err)
+		}
+	}()
+}
+
+// Reconciliations returns the most recent actions taken by the reconciler,
+// newest first.
+func (tr *TxReconciler) Reconciliations() []models.TxReconciliation {
+	tr.mutex.Lock()
+	defer tr.mutex.Unlock()
+
+	reconciliations := make([]models.TxReconciliation, len(tr.reconciliations))
+	for i, r := range tr.reconciliations {
+		reconciliations[len(reconciliations)-1-i] = r
+	}
+	return reconciliations
+}
+
+// Reconcile scans the unconfirmed transactions, confirming those which have
+// been mined, and marking those replaced by a transaction sent outside of the
+// node with the same key, which errors the runs waiting on them. The latest
+// attempts of the rest are bumped to a higher gas price once they have waited
+// ETH_GAS_BUMP_THRESHOLD blocks, or rebroadcast otherwise, which also keeps
+// the transactions that fill nonce gaps moving. The nonces of the active
+// accounts are then reloaded if they are behind the chain, and any gaps in
+// them that the chain has no pending transaction for are filled with zero
+// value transactions to the account itself. The actions taken are returned.
+func (tr *TxReconciler) Reconcile() ([]models.TxReconciliation, error) {
+	tr.mutex.Lock()
+	tr.lastReconciled = time.Now()
+	tr.mutex.Unlock()
+
+	blkNum, err := tr.txm.getBlockNumber()
+	if err != nil {
+		return nil, err
+	}
+	txs, err := tr.txm.orm.UnconfirmedTxs()
+	if err != nil {
+		return nil, err
+	}
+
+	nonces := map[common.Address]uint64{}
+	var actions []models.TxReconciliation
+	var merr error
+	for i := range txs {
+		tx := &txs[i]
+		if tx.Replaced {
+			continue
+		}
+		action, err := tr.reconcileTx(tx, blkNum, nonces)
+		if err != nil {
+			merr = multierr.Append(merr, fmt.Errorf("reconciling tx %v: %v", tx.ID, err))
+		} else if action != nil {
+			actions = append(actions, *action)
+		}
+	}
+
+	for _, ma := range tr.txm.activeAccounts() {
+		accountActions, err := tr.reconcileAccount(ma, blkNum, nonces)
+		actions = append(actions, accountActions...)
+		merr = multierr.Append(merr, err)
+	}
+
+	return tr.record(actions), merr
+}
+
+func (tr *TxReconciler) reconcileTx(
+	tx *models.Tx,
+	blkNum uint64,
+	nonces map[common.Address]uint64,
+) (*models.TxReconciliation, error) {
+	nonce, err := tr.chainNonce(tx.From, nonces)
+	if err != nil {
+		return nil, err
+	}
+
+	// The transaction may be checked and bumped by a run waiting on it, or
+	// replaced through the API, since it was loaded.
+	defer tr.txm.lockTx(tx.ID)()
+	if tx, err = tr.txm.orm.FindTx(tx.ID); err != nil {
+		return nil, err
+	} else if tx.Confirmed || tx.Replaced {
+		return nil, nil
+	}
+	attempts, err := tr.txm.orm.TxAttemptsFor(tx.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range attempts {
+		txat := &attempts[i]
+		receipt, err := tr.txm.GetTxReceipt(txat.Hash)
+		if err != nil {
+			return nil, err
+		}
+		if receipt.Unconfirmed() {
+			continue
+		}
+		receipt, err = tr.txm.handleConfirmed(tx, txat, receipt, blkNum)
+		if _, reverted := err.(*RevertError); err != nil && !reverted {
+			return nil, err
+		}
+		if receipt == nil {
+			return nil, nil
+		}
+		return newTxReconciliation(models.TxReconcileConfirmed, tx, &txat.Hash), nil
+	}
+
+	if nonce > tx.Nonce {
+		tx.Replaced = true
+		if err := tr.txm.orm.SaveTx(tx); err != nil {
+			return nil, err
+		}
+		return newTxReconciliation(models.TxReconcileReplaced, tx, &tx.Hash), nil
+	}
+
+	if blkNum >= tx.SentAt+tr.txm.config.EthGasBumpThreshold() {
+		gasPrice := tr.txm.gasPriceEstimator.BumpedGasPrice(tx.GasPrice)
+		if gasPrice.Cmp(tx.GasPrice) > 0 {
+			txat, err := tr.txm.bumpGas(&tx.TxAttempt, gasPrice, blkNum)
+			if err != nil {
+				return nil, err
+			}
+			return newTxReconciliation(models.TxReconcileGasBumped, tx, &txat.Hash), nil
+		}
+	}
+
+	if _, err := tr.txm.SendRawTx(tx.Hex); err != nil {
+		if knownTxRegex.MatchString(err.Error()) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return newTxReconciliation(models.TxReconcileRebroadcast, tx, &tx.Hash), nil
+}
+
+func (tr *TxReconciler) reconcileAccount(
+	ma *ManagedAccount,
+	blkNum uint64,
+	nonces map[common.Address]uint64,
+) ([]models.TxReconciliation, error) {
+	nonce, err := tr.chainNonce(ma.Address, nonces)
+	if err != nil {
+		return nil, err
+	}
+
+	// Hold the account's nonce for the whole scan, so that a transaction
+	// being created with it is not taken for a gap.
+	ma.mutex.Lock()
+	defer ma.mutex.Unlock()
+
+	var actions []models.TxReconciliation
+	if ma.nonce < nonce {
+		if err := ma.reloadNonce(tr.txm); err != nil {
+			return nil, err
+		}
+		actions = append(actions, models.TxReconciliation{
+			Action: models.TxReconcileNonceReloaded,
+			From:   ma.Address,
+			Nonce:  ma.nonce,
+		})
+	}
+
+	txs, err := tr.txm.orm.TxFrom(ma.Address)
+	if err != nil {
+		return actions, err
+	}
+	used := map[uint64]bool{}
+	for _, tx := range txs {
+		used[tx.Nonce] = true
+	}
+	var gaps []uint64
+	for n := nonce; n < ma.nonce; n++ {
+		if !used[n] {
+			gaps = append(gaps, n)
+		}
+	}
+	if len(gaps) == 0 {
+		return actions, nil
+	}
+
+	// The nonces below the chain's pending nonce already have a transaction
+	// in the pool, sent outside of the node with the same key, which a zero
+	// value transaction would replace.
+	pending, err := tr.txm.GetPendingNonce(ma.Address)
+	if err != nil {
+		return actions, err
+	}
+
+	var merr error
+	for _, n := range gaps {
+		if n < pending {
+			continue
+		}
+		tx, err := tr.fillNonceGap(ma, n, blkNum)
+		if err != nil {
+			merr = multierr.Append(merr, fmt.Errorf("filling nonce %v of %v: %v", n, ma.Address.Hex(), err))
+			continue
+		}
+		actions = append(actions, *newTxReconciliation(models.TxReconcileNonceGapFilled, tx, &tx.Hash))
+	}
+	return actions, merr
+}
+
+// fillNonceGap sends a zero value transaction from the account to itself
+// with the given nonce, so that the transactions after it can be mined.
+func (tr *TxReconciler) fillNonceGap(ma *ManagedAccount, nonce uint64, blkNum uint64) (*models.Tx, error) {
+	tx, err := tr.txm.orm.CreateTx(ma.Address, nonce, ma.Address, []byte{}, big.NewInt(0), selfSendGasLimit)
+	if err != nil {
+		return nil, err
+	}
+	txat, err := tr.txm.createAttempt(tx, tr.txm.gasPriceEstimator.GasPrice(), blkNum)
+	if err != nil {
+		tr.txm.orm.DeleteStruct(tx)
+		if txat != nil {
+			tr.txm.orm.DeleteStruct(txat)
+		}
+		return nil, err
+	}
+	return tx, nil
+}
+
+// chainNonce returns the nonce of the account's next transaction to be mined,
+// caching it for the rest of the reconciliation.
+func (tr *TxReconciler) chainNonce(address common.Address, nonces map[common.Address]uint64) (uint64, error) {
+	if nonce, ok := nonces[address]; ok {
+		return nonce, nil
+	}
+	nonce, err := tr.txm.GetNonce(address)
+	if err != nil {
+		return 0, err
+	}
+	nonces[address] = nonce
+	return nonce, nil
+}
+
+// record timestamps and logs the actions, keeping the most recent to report.
+func (tr *TxReconciler) record(actions []models.TxReconciliation) []models.TxReconciliation {
+	tr.mutex.Lock()
+	defer tr.mutex.Unlock()
+
+	now := time.Now()
+	for i := range actions {
+		tr.nextID++
+		actions[i].ID = tr.nextID
+		actions[i].CreatedAt = now
+		logger.Infow(
+			fmt.Sprintf("Reconciled tx with nonce %v from %v: %v", actions[i].Nonce, actions[i].From.Hex(), actions[i].Action),
+			"txid", actions[i].TxID,
+			"txHash", actions[i].TxHash,
+		)
+	}
+
+	tr.reconciliations = append(tr.reconciliations, actions...)
+	if len(tr.reconciliations) > maxTxReconciliations {
+		tr.reconciliations = tr.reconciliations[len(tr.reconciliations)-maxTxReconciliations:]
+	}
+	return actions
+}
+
+func newTxReconciliation(action string, tx *models.Tx, hash *common.Hash) *models.TxReconciliation {
+	return &models.TxReconciliation{
+		Action: action,
+		From:   tx.From,
+		Nonce:  tx.Nonce,
+		TxID:   tx.ID,
+		TxHash: hash,
+	}
+}
@@ -0,0 +1,162 @@
+package store_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/internal/cltest"
+	strpkg "github.com/smartcontractkit/chainlink/store"
+	"github.com/smartcontractkit/chainlink/store/models"
+	"github.com/smartcontractkit/chainlink/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTxReconciler_Reconcile(t *testing.T) {
+	t.Parallel()
+
+	sentAt := uint64(100)
+	tests := []struct {
+		name          string
+		localNonce    uint64
+		chainNonce    uint64
+		blocksAfter   uint64
+		receipt       strpkg.TxReceipt
+		extraMocks    func(*cltest.EthMock)
+		wantActions   []string
+		wantConfirmed bool
+		wantReplaced  bool
+	}{
+		{"rebroadcast", 0, 0, 1, strpkg.TxReceipt{}, func(ethMock *cltest.EthMock) {
+			ethMock.Register("eth_sendRawTransaction", cltest.NewHash())
+		}, []string{models.TxReconcileRebroadcast}, false, false},
+		{"gas bumped", 0, 0, 6, strpkg.TxReceipt{}, func(ethMock *cltest.EthMock) {
+			ethMock.Register("eth_sendRawTransaction", cltest.NewHash())
+		}, []string{models.TxReconcileGasBumped}, false, false},
+		{"replaced", 0, 1, 6, strpkg.TxReceipt{}, func(ethMock *cltest.EthMock) {
+			ethMock.Register("eth_getTransactionCount", utils.Uint64ToHex(1))
+		}, []string{models.TxReconcileReplaced, models.TxReconcileNonceReloaded}, false, true},
+		{"confirmed", 1, 1, 6, strpkg.TxReceipt{Hash: cltest.NewHash(), BlockNumber: cltest.Int(sentAt)}, func(*cltest.EthMock) {},
+			[]string{models.TxReconcileConfirmed}, true, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			app, cleanup := cltest.NewApplicationWithKeyStore()
+			defer cleanup()
+			store := app.Store
+
+			ethMock := app.MockEthClient()
+			ethMock.Context("app.Start()", func(ethMock *cltest.EthMock) {
+				ethMock.Register("eth_getTransactionCount", utils.Uint64ToHex(test.localNonce))
+			})
+			require.NoError(t, app.StartAndConnect())
+
+			tx := cltest.CreateTxAndAttempt(store, cltest.GetAccountAddress(store), sentAt)
+			ethMock.Context("TxReconciler.Reconcile()", func(ethMock *cltest.EthMock) {
+				ethMock.Register("eth_blockNumber", utils.Uint64ToHex(sentAt+test.blocksAfter))
+				ethMock.Register("eth_getTransactionCount", utils.Uint64ToHex(test.chainNonce))
+				ethMock.Register("eth_getTransactionReceipt", test.receipt)
+				test.extraMocks(ethMock)
+			})
+
+			actions, err := store.TxReconciler.Reconcile()
+			require.NoError(t, err)
+			require.Len(t, actions, len(test.wantActions))
+			for i, action := range test.wantActions {
+				assert.Equal(t, action, actions[i].Action)
+			}
+			assert.Equal(t, tx.ID, actions[0].TxID)
+			reconciliations := store.TxReconciler.Reconciliations()
+			require.Len(t, reconciliations, len(actions))
+			assert.Equal(t, actions[len(actions)-1], reconciliations[0])
+
+			tx, err = store.FindTx(tx.ID)
+			require.NoError(t, err)
+			assert.Equal(t, test.wantConfirmed, tx.Confirmed)
+			assert.Equal(t, test.wantReplaced, tx.Replaced)
+			ethMock.EventuallyAllCalled(t)
+
+			if test.wantReplaced {
+				ethMock.Register("eth_blockNumber", utils.Uint64ToHex(sentAt+test.blocksAfter))
+				_, err = store.TxManager.BumpGasUntilSafe(tx.Hash)
+				assert.Equal(t, strpkg.ErrTxReplaced, err)
+			}
+		})
+	}
+}
+
+func TestTxReconciler_Reconcile_FillsNonceGaps(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplicationWithKeyStore()
+	defer cleanup()
+	store := app.Store
+	from := cltest.GetAccountAddress(store)
+
+	ethMock := app.MockEthClient()
+	ethMock.Context("app.Start()", func(ethMock *cltest.EthMock) {
+		ethMock.Register("eth_getTransactionCount", utils.Uint64ToHex(2))
+	})
+	require.NoError(t, app.StartAndConnect())
+
+	tx := cltest.NewTx(from, 1)
+	tx.Nonce = 1
+	require.NoError(t, store.SaveTx(tx))
+	txat, err := store.AddTxAttempt(tx, tx.EthTx(big.NewInt(1)), 1)
+	require.NoError(t, err)
+	require.NoError(t, store.ConfirmTx(tx, txat))
+
+	ethMock.Register("eth_blockNumber", utils.Uint64ToHex(10))
+	ethMock.Register("eth_getTransactionCount", utils.Uint64ToHex(0))
+	ethMock.Register("eth_getTransactionCount", utils.Uint64ToHex(0))
+	ethMock.Register("eth_sendRawTransaction", cltest.NewHash(),
+		func(_ interface{}, data ...interface{}) error {
+			rlp := data[0].([]interface{})[0].(string)
+			etx, err := utils.DecodeEthereumTx(rlp)
+			require.NoError(t, err)
+			assert.Equal(t, uint64(0), etx.Nonce())
+			assert.Equal(t, from, *etx.To())
+			assert.Equal(t, int64(0), etx.Value().Int64())
+			return nil
+		})
+
+	actions, err := store.TxReconciler.Reconcile()
+	require.NoError(t, err)
+	require.Len(t, actions, 1)
+	assert.Equal(t, models.TxReconcileNonceGapFilled, actions[0].Action)
+	assert.Equal(t, uint64(0), actions[0].Nonce)
+
+	txs, err := store.TxFrom(from)
+	require.NoError(t, err)
+	assert.Len(t, txs, 2)
+	ethMock.EventuallyAllCalled(t)
+}
+
+func TestTxReconciler_Reconcile_SkipsPendingNonces(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplicationWithKeyStore()
+	defer cleanup()
+	store := app.Store
+	from := cltest.GetAccountAddress(store)
+
+	ethMock := app.MockEthClient()
+	ethMock.Context("app.Start()", func(ethMock *cltest.EthMock) {
+		ethMock.Register("eth_getTransactionCount", utils.Uint64ToHex(2))
+	})
+	require.NoError(t, app.StartAndConnect())
+
+	ethMock.Register("eth_blockNumber", utils.Uint64ToHex(10))
+	ethMock.Register("eth_getTransactionCount", utils.Uint64ToHex(0))
+	ethMock.Register("eth_getTransactionCount", utils.Uint64ToHex(2))
+
+	actions, err := store.TxReconciler.Reconcile()
+	require.NoError(t, err)
+	assert.Len(t, actions, 0)
+
+	txs, err := store.TxFrom(from)
+	require.NoError(t, err)
+	assert.Len(t, txs, 0)
+	ethMock.EventuallyAllCalled(t)
+}
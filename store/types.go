@@ -10,4 +10,5 @@ type HeadTrackable interface {
 	Connect(*models.IndexableBlockNumber) error
 	Disconnect()
 	OnNewHead(*models.BlockHeader)
+	OnReorg(*models.Reorg)
 }
@@ -271,3 +271,43 @@ func init() {
 	MaxInt256 = new(big.Int).Div(MaxUint256, big.NewInt(2))
 	MinInt256 = new(big.Int).Neg(MaxInt256)
 }
+
+// revertSelector is the function selector of Error(string), which Solidity
+// uses to encode the reason given to revert and require.
+var revertSelector = []byte{0x08, 0xc3, 0x79, 0xa0}
+
+// ExtractRevertReason returns the reason encoded in the return data of a
+// reverted call, and false if the data is not an encoded reason.
+func ExtractRevertReason(data []byte) (string, bool) {
+	if len(data) < len(revertSelector) || !bytes.Equal(data[:len(revertSelector)], revertSelector) {
+		return "", false
+	}
+	args := data[len(revertSelector):]
+
+	offset, ok := evmWordToInt(args, 0)
+	if !ok {
+		return "", false
+	}
+	length, ok := evmWordToInt(args, offset)
+	if !ok {
+		return "", false
+	}
+	start := offset + EVMWordByteLen
+	if start+length > len(args) || start+length < start {
+		return "", false
+	}
+	return string(args[start : start+length]), true
+}
+
+// evmWordToInt reads the EVM word at index of data as an int, returning
+// false if it is out of bounds or too large to index data with.
+func evmWordToInt(data []byte, index int) (int, bool) {
+	if index < 0 || index+EVMWordByteLen > len(data) {
+		return 0, false
+	}
+	word := new(big.Int).SetBytes(data[index : index+EVMWordByteLen])
+	if !word.IsInt64() || word.Int64() > int64(len(data)) {
+		return 0, false
+	}
+	return int(word.Int64()), true
+}
@@ -505,3 +505,27 @@ func TestParseDecimalString(t *testing.T) {
 		assert.Equal(t, test.output, out.String())
 	}
 }
+
+func TestExtractRevertReason(t *testing.T) {
+	tests := []struct {
+		name   string
+		data   string
+		reason string
+		ok     bool
+	}{
+		{"reason", "0x08c379a00000000000000000000000000000000000000000000000000000000000000020000000000000000000000000000000000000000000000000000000000000000f4e6f7420656e6f756768204c494e4b0000000000000000000000000000000000", "Not enough LINK", true},
+		{"empty reason", "0x08c379a000000000000000000000000000000000000000000000000000000000000000200000000000000000000000000000000000000000000000000000000000000000", "", true},
+		{"no data", "0x", "", false},
+		{"return value", "0x0000000000000000000000000000000000000000000000000000000000000001", "", false},
+		{"truncated", "0x08c379a00000000000000000000000000000000000000000000000000000000000000020000000000000000000000000000000000000000000000000000000000000000f4e6f74", "", false},
+		{"huge offset", "0x08c379a0ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff", "", false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			reason, ok := ExtractRevertReason(hexutil.MustDecode(test.data))
+			assert.Equal(t, test.ok, ok)
+			assert.Equal(t, test.reason, reason)
+		})
+	}
+}
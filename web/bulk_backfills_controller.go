@@ -0,0 +1,61 @@
+package web
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/gin-gonic/gin"
+	"github.com/manyminds/api2go/jsonapi"
+	"github.com/smartcontractkit/chainlink/services"
+	"github.com/smartcontractkit/chainlink/store/models"
+	"github.com/smartcontractkit/chainlink/store/orm"
+)
+
+// BulkBackfillsController manages background tasks that create runs for the
+// historical logs of log initiated jobs
+type BulkBackfillsController struct {
+	App services.Application
+}
+
+// Create queues a task to create runs for a job's logs between two blocks
+// Example:
+//  "<application>/bulk_backfill_logs"
+func (c *BulkBackfillsController) Create(ctx *gin.Context) {
+	request := models.BackfillLogsRequest{}
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.AbortWithError(422, err)
+	} else if task, err := models.NewBulkBackfillLogsTask(request); err != nil {
+		ctx.AbortWithError(422, err)
+	} else if job, err := c.App.GetStore().FindJob(request.JobID); err == orm.ErrorNotFound {
+		ctx.AbortWithError(404, errors.New("Job not found"))
+	} else if err != nil {
+		ctx.AbortWithError(500, err)
+	} else if !job.IsLogInitiated() {
+		ctx.AbortWithError(422, fmt.Errorf("Job %s is not log initiated", job.ID))
+	} else if err := c.App.GetStore().ORM.DB.Save(task); err != nil {
+		ctx.AbortWithError(500, err)
+	} else if doc, err := jsonapi.Marshal(task); err != nil {
+		ctx.AbortWithError(500, err)
+	} else {
+		c.App.WakeBulkLogBackfiller()
+		ctx.Data(201, MediaType, doc)
+	}
+}
+
+// Show returns the details of a BulkBackfillLogsTask.
+// Example:
+//  "<application>/bulk_backfill_logs/:taskID"
+func (c *BulkBackfillsController) Show(ctx *gin.Context) {
+	id := ctx.Param("taskID")
+	task := models.BulkBackfillLogsTask{}
+
+	if err := c.App.GetStore().ORM.DB.One("ID", id, &task); err == orm.ErrorNotFound {
+		ctx.AbortWithError(404, errors.New("Bulk backfill task not found"))
+	} else if err != nil {
+		ctx.AbortWithError(500, err)
+	} else if doc, err := jsonapi.Marshal(&task); err != nil {
+		ctx.AbortWithError(500, err)
+	} else {
+		ctx.Data(200, MediaType, doc)
+	}
+}
@@ -0,0 +1,74 @@
+package web_test
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/internal/cltest"
+	"github.com/smartcontractkit/chainlink/store/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBulkBackfillsController_Create(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplication()
+	defer cleanup()
+	client := app.NewHTTPClient()
+
+	logJob, _ := cltest.NewJobWithLogInitiator()
+	require.NoError(t, app.Store.SaveJob(&logJob))
+	webJob, _ := cltest.NewJobWithWebInitiator()
+	require.NoError(t, app.Store.SaveJob(&webJob))
+
+	tests := []struct {
+		name   string
+		body   string
+		status int
+	}{
+		{"log initiated job", fmt.Sprintf(`{"jobId":"%s","fromBlock":1,"toBlock":10}`, logJob.ID), 201},
+		{"web initiated job", fmt.Sprintf(`{"jobId":"%s","fromBlock":1,"toBlock":10}`, webJob.ID), 422},
+		{"unknown job", `{"jobId":"deadbeef","fromBlock":1,"toBlock":10}`, 404},
+		{"inverted range", fmt.Sprintf(`{"jobId":"%s","fromBlock":10,"toBlock":1}`, logJob.ID), 422},
+		{"missing job", `{"fromBlock":1,"toBlock":10}`, 422},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resp, cleanup := client.Post("/v2/bulk_backfill_logs", bytes.NewBufferString(test.body))
+			defer cleanup()
+			cltest.AssertServerResponse(t, resp, test.status)
+		})
+	}
+}
+
+func TestBulkBackfillsController_Show(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplication()
+	defer cleanup()
+	client := app.NewHTTPClient()
+
+	task, err := models.NewBulkBackfillLogsTask(models.BackfillLogsRequest{
+		JobID:     "deadbeef",
+		FromBlock: 1,
+		ToBlock:   10,
+	})
+	require.NoError(t, err)
+	require.NoError(t, app.Store.ORM.DB.Save(task))
+
+	resp, cleanup := client.Get("/v2/bulk_backfill_logs/" + task.ID)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, 200)
+
+	var shown models.BulkBackfillLogsTask
+	require.NoError(t, cltest.ParseJSONAPIResponse(resp, &shown))
+	assert.Equal(t, task.ID, shown.ID)
+	assert.Equal(t, uint64(10), shown.Query.ToBlock)
+
+	resp, cleanup = client.Get("/v2/bulk_backfill_logs/missing")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, 404)
+}
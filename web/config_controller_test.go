@@ -35,14 +35,24 @@ func TestConfigController_Show(t *testing.T) {
 	assert.Equal(t, uint16(6689), cwl.TLSPort)
 	assert.Equal(t, "", cwl.TLSHost)
 	assert.Contains(t, cwl.EthereumURL, "ws://127.0.0.1:")
+	assert.Equal(t, "", cwl.EthereumSecondaryURLs)
+	assert.Equal(t, 2*time.Minute, cwl.EthNodeMaxHeadAge)
 	assert.Equal(t, uint64(3), cwl.ChainID)
 	assert.Contains(t, cwl.ClientNodeURL, "http://127.0.0.1:")
 	assert.Equal(t, uint64(6), cwl.MinOutgoingConfirmations)
 	assert.Equal(t, uint64(0), cwl.MinIncomingConfirmations)
+	assert.Equal(t, uint64(0), cwl.EthBalanceMonitorBlocks)
+	assert.Equal(t, big.NewInt(0), cwl.EthBalanceThreshold)
 	assert.Equal(t, uint64(3), cwl.EthGasBumpThreshold)
 	assert.Equal(t, uint64(300), cwl.MinimumRequestExpiration)
+	assert.Equal(t, uint64(100), cwl.MaxConcurrentRuns)
 	assert.Equal(t, big.NewInt(5000000000), cwl.EthGasBumpWei)
 	assert.Equal(t, big.NewInt(20000000000), cwl.EthGasPriceDefault)
+	assert.Equal(t, "fixed", cwl.EthGasPriceEstimator)
+	assert.Equal(t, big.NewInt(500000000000), cwl.EthGasPriceMax)
+	assert.Equal(t, 1.25, cwl.EthGasLimitMultiplier)
+	assert.False(t, cwl.EthTxPreflight)
+	assert.Equal(t, time.Duration(0), cwl.EthTxReconcilePeriod)
 	assert.Equal(t, store.NewConfig().LinkContractAddress(), cwl.LinkContractAddress)
 	assert.Equal(t, assets.NewLink(100), cwl.MinimumContractPayment)
 	assert.Equal(t, (*common.Address)(nil), cwl.OracleContractAddress)
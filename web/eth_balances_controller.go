@@ -0,0 +1,28 @@
+package web
+
+import (
+	"fmt"
+
+	"github.com/gin-gonic/gin"
+	"github.com/manyminds/api2go/jsonapi"
+	"github.com/smartcontractkit/chainlink/services"
+)
+
+// EthBalancesController exposes the ETH balances of the node's accounts as
+// last checked by the balance monitor.
+type EthBalancesController struct {
+	App services.Application
+}
+
+// Index returns the ETH balance of each active account and whether it is
+// below ETH_BALANCE_THRESHOLD.
+// Example:
+//  "<application>/eth_balances"
+func (ebc *EthBalancesController) Index(c *gin.Context) {
+	balances := ebc.App.GetStore().BalanceMonitor.Balances()
+	if json, err := jsonapi.Marshal(balances); err != nil {
+		c.AbortWithError(500, fmt.Errorf("failed to marshal eth balances using jsonapi: %+v", err))
+	} else {
+		c.Data(200, MediaType, json)
+	}
+}
@@ -0,0 +1,41 @@
+package web_test
+
+import (
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/internal/cltest"
+	"github.com/smartcontractkit/chainlink/store/assets"
+	"github.com/smartcontractkit/chainlink/store/models"
+	"github.com/smartcontractkit/chainlink/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEthBalancesController_Index(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplicationWithKeyStore()
+	defer cleanup()
+	ethMock := app.MockEthClient()
+	ethMock.Context("app.StartAndConnect()", func(ethMock *cltest.EthMock) {
+		ethMock.Register("eth_getTransactionCount", utils.Uint64ToHex(0))
+	})
+	require.NoError(t, app.StartAndConnect())
+	client := app.NewHTTPClient()
+
+	app.Store.Config.Set("ETH_BALANCE_THRESHOLD", "1000")
+	ethMock.Register("eth_getBalance", "0x64")
+	require.NoError(t, app.Store.BalanceMonitor.CheckBalances(5))
+
+	resp, cleanup := client.Get("/v2/eth_balances")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, 200)
+
+	var balances []models.EthBalance
+	require.NoError(t, cltest.ParseJSONAPIResponse(resp, &balances))
+	require.Len(t, balances, 1)
+	assert.Equal(t, cltest.GetAccountAddress(app.Store), balances[0].Address)
+	assert.Equal(t, assets.NewEth(100), balances[0].Balance)
+	assert.True(t, balances[0].Low)
+	assert.Equal(t, uint64(5), balances[0].BlockNumber)
+}
@@ -0,0 +1,27 @@
+package web
+
+import (
+	"fmt"
+
+	"github.com/gin-gonic/gin"
+	"github.com/manyminds/api2go/jsonapi"
+	"github.com/smartcontractkit/chainlink/services"
+)
+
+// EthNodesController exposes the health of the Ethereum nodes.
+type EthNodesController struct {
+	App services.Application
+}
+
+// Index returns the Ethereum nodes in order of priority, along with which is
+// active and the result of their latest health check.
+// Example:
+//  "<application>/eth_nodes"
+func (enc *EthNodesController) Index(c *gin.Context) {
+	statuses := enc.App.GetStore().EthNodes.Statuses()
+	if json, err := jsonapi.Marshal(statuses); err != nil {
+		c.AbortWithError(500, fmt.Errorf("failed to marshal Ethereum nodes using jsonapi: %+v", err))
+	} else {
+		c.Data(200, MediaType, json)
+	}
+}
@@ -0,0 +1,30 @@
+package web_test
+
+import (
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/internal/cltest"
+	"github.com/smartcontractkit/chainlink/store/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEthNodesController_Index(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplication()
+	defer cleanup()
+	client := app.NewHTTPClient()
+
+	resp, cleanup := client.Get("/v2/eth_nodes")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, 200)
+
+	var statuses []models.EthNodeStatus
+	require.NoError(t, cltest.ParseJSONAPIResponse(resp, &statuses))
+	require.Len(t, statuses, 1)
+	assert.Equal(t, app.Store.Config.EthereumURL(), statuses[0].URL)
+	assert.True(t, statuses[0].Active)
+	assert.True(t, statuses[0].Healthy)
+	assert.Nil(t, statuses[0].LastCheckedAt)
+}
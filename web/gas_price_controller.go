@@ -0,0 +1,27 @@
+package web
+
+import (
+	"fmt"
+
+	"github.com/gin-gonic/gin"
+	"github.com/manyminds/api2go/jsonapi"
+	"github.com/smartcontractkit/chainlink/services"
+)
+
+// GasPriceController exposes the gas price outgoing transactions are sent
+// with.
+type GasPriceController struct {
+	App services.Application
+}
+
+// Show returns the current gas price estimate and how it was estimated.
+// Example:
+//  "<application>/gas_price"
+func (gpc *GasPriceController) Show(c *gin.Context) {
+	estimate := gpc.App.GetStore().GasPriceEstimator.Estimate()
+	if json, err := jsonapi.Marshal(estimate); err != nil {
+		c.AbortWithError(500, fmt.Errorf("failed to marshal gas price using jsonapi: %+v", err))
+	} else {
+		c.Data(200, MediaType, json)
+	}
+}
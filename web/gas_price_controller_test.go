@@ -0,0 +1,29 @@
+package web_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/internal/cltest"
+	"github.com/smartcontractkit/chainlink/store/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGasPriceController_Show(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplication()
+	defer cleanup()
+	client := app.NewHTTPClient()
+
+	resp, cleanup := client.Get("/v2/gas_price")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, 200)
+
+	var estimate models.GasPriceEstimate
+	require.NoError(t, cltest.ParseJSONAPIResponse(resp, &estimate))
+	assert.Equal(t, "fixed", estimate.Estimator)
+	assert.Equal(t, big.NewInt(20000000000), estimate.GasPrice)
+	assert.Equal(t, big.NewInt(500000000000), estimate.Max)
+}
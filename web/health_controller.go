@@ -0,0 +1,24 @@
+package web
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/smartcontractkit/chainlink/services"
+)
+
+// HealthController reports whether the node is able to send transactions,
+// for use by load balancers and monitoring.
+type HealthController struct {
+	App services.Application
+}
+
+// Show responds with 200 if the node is healthy, and 503 with the reason if
+// any account's ETH balance is below ETH_BALANCE_THRESHOLD.
+// Example:
+//  "<application>/health"
+func (hc *HealthController) Show(c *gin.Context) {
+	if err := hc.App.GetStore().BalanceMonitor.CheckHealth(); err != nil {
+		publicError(c, 503, err)
+	} else {
+		c.JSON(200, gin.H{"healthy": true})
+	}
+}
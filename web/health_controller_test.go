@@ -0,0 +1,38 @@
+package web_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/internal/cltest"
+	"github.com/smartcontractkit/chainlink/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHealthController_Show(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplicationWithKeyStore()
+	defer cleanup()
+	ethMock := app.MockEthClient()
+	ethMock.Context("app.StartAndConnect()", func(ethMock *cltest.EthMock) {
+		ethMock.Register("eth_getTransactionCount", utils.Uint64ToHex(0))
+	})
+	require.NoError(t, app.StartAndConnect())
+
+	resp, err := http.Get(app.Server.URL + "/health")
+	require.NoError(t, err)
+	cltest.AssertServerResponse(t, resp, 200)
+
+	app.Store.Config.Set("ETH_BALANCE_THRESHOLD", "1000")
+	ethMock.Register("eth_getBalance", "0x64")
+	require.NoError(t, app.Store.BalanceMonitor.CheckBalances(5))
+
+	resp, err = http.Get(app.Server.URL + "/health")
+	require.NoError(t, err)
+	cltest.AssertServerResponse(t, resp, 503)
+	body := string(cltest.ParseResponseBody(resp))
+	assert.Contains(t, body, "1 account(s) with sending enabled have no ETH or a balance below")
+	assert.NotContains(t, body, cltest.GetAccountAddress(app.Store).Hex())
+}
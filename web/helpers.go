@@ -15,6 +15,16 @@ func StatusCodeForError(err interface{}) int {
 	}
 }
 
+// authenticatedUser returns the user of the request's session.
+func authenticatedUser(c *gin.Context) (models.User, bool) {
+	user, ok := c.Get(SessionUserKey)
+	if !ok {
+		return models.User{}, false
+	}
+	u, ok := user.(models.User)
+	return u, ok
+}
+
 // publicError adds an error to the gin context and sets
 // the JSON value of errors.
 func publicError(c *gin.Context, statusCode int, err error) {
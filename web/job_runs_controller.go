@@ -68,6 +68,8 @@ func (jrc *JobRunsController) Create(c *gin.Context) {
 		c.AbortWithError(500, err)
 	} else if !j.WebAuthorized() {
 		c.AbortWithError(403, errors.New("Job not available on web API, recreate with web initiator"))
+	} else if !j.Active() {
+		c.AbortWithError(409, fmt.Errorf("Cannot run a job with status %s", j.Status))
 	} else if data, err := getRunData(c); err != nil {
 		c.AbortWithError(500, err)
 	} else if jr, err := services.ExecuteJob(j, j.InitiatorsFor(models.InitiatorWeb)[0], models.RunResult{Data: data}, nil, jrc.App.GetStore()); err != nil {
@@ -104,27 +106,80 @@ func (jrc *JobRunsController) Show(c *gin.Context) {
 }
 
 // Update allows external adapters to resume a JobRun, reporting the result of
-// the task and marking it no longer pending.
+// the task and marking it no longer pending. Runs with several branches
+// awaiting external adapters are resumed one task run at a time.
 // Example:
 //  "<application>/runs/:RunID"
+//  "<application>/runs/:RunID/task_runs/:TaskRunID"
 func (jrc *JobRunsController) Update(c *gin.Context) {
 	id := c.Param("RunID")
+	taskRunID := c.Param("TaskRunID")
 	var brr models.BridgeRunResult
 	if jr, err := jrc.App.GetStore().FindJobRun(id); err == orm.ErrorNotFound {
 		c.AbortWithError(404, errors.New("Job Run not found"))
 	} else if err != nil {
 		c.AbortWithError(500, err)
-	} else if !jr.Result.Status.PendingBridge() {
-		c.AbortWithError(405, errors.New("Cannot resume a job run that isn't pending"))
+	} else if index, err := jr.BridgeTaskRunIndex(taskRunID); err != nil {
+		c.AbortWithError(405, fmt.Errorf("Cannot resume a job run that isn't pending: %v", err))
 	} else if err := c.ShouldBindJSON(&brr); err != nil {
 		c.AbortWithError(500, err)
-	} else if bt, err := jrc.App.GetStore().PendingBridgeType(jr); err != nil {
+	} else if bt, err := jrc.App.GetStore().FindBridge(jr.TaskRuns[index].Task.Type.String()); err != nil {
 		c.AbortWithError(500, err)
 	} else if _, err := bt.Authenticate(utils.StripBearer(c.Request.Header.Get("Authorization"))); err != nil {
 		publicError(c, http.StatusUnauthorized, err)
-	} else if _, err = services.ResumePendingTask(&jr, jrc.App.GetStore(), brr.RunResult); err != nil {
+	} else if _, err = services.ResumePendingTask(&jr, jrc.App.GetStore(), taskRunID, brr.RunResult); err != nil {
 		c.AbortWithError(500, err)
 	} else {
 		c.JSON(200, gin.H{"id": jr.ID})
 	}
 }
+
+// Cancel stops a JobRun that has not yet finished. Runs that have already
+// broadcast an ethereum transaction are only cancelled with force.
+// Example:
+//  "<application>/runs/:RunID/cancel?force=true"
+func (jrc *JobRunsController) Cancel(c *gin.Context) {
+	id := c.Param("RunID")
+	force := c.Query("force") == "true"
+	if jr, err := jrc.App.GetStore().FindJobRun(id); err == orm.ErrorNotFound {
+		c.AbortWithError(404, errors.New("Job Run not found"))
+	} else if err != nil {
+		c.AbortWithError(500, err)
+	} else if jr.Status.Finished() {
+		c.AbortWithError(409, fmt.Errorf("Cannot cancel a job run with status %s", jr.Status))
+	} else if _, err := services.CancelRun(&jr, jrc.App.GetStore(), force); err != nil {
+		if _, ok := err.(services.EthTxBroadcastError); ok {
+			c.AbortWithError(409, err)
+		} else {
+			c.AbortWithError(500, err)
+		}
+	} else if doc, err := jsonapi.Marshal(presenters.JobRun{JobRun: jr}); err != nil {
+		c.AbortWithError(500, err)
+	} else {
+		c.Data(200, MediaType, doc)
+	}
+}
+
+// Resume executes an errored JobRun again from its errored tasks, merging the
+// optional params in the body into the params of those tasks.
+// Example:
+//  "<application>/runs/:RunID/resume"
+func (jrc *JobRunsController) Resume(c *gin.Context) {
+	id := c.Param("RunID")
+	user, _ := authenticatedUser(c)
+	if jr, err := jrc.App.GetStore().FindJobRun(id); err == orm.ErrorNotFound {
+		c.AbortWithError(404, errors.New("Job Run not found"))
+	} else if err != nil {
+		c.AbortWithError(500, err)
+	} else if !jr.Status.Errored() {
+		c.AbortWithError(409, fmt.Errorf("Cannot resume a job run with status %s", jr.Status))
+	} else if params, err := getRunData(c); err != nil {
+		c.AbortWithError(422, err)
+	} else if _, err := services.ResumeErroredRun(&jr, jrc.App.GetStore(), params, user.Email); err != nil {
+		c.AbortWithError(500, err)
+	} else if doc, err := jsonapi.Marshal(presenters.JobRun{JobRun: jr}); err != nil {
+		c.AbortWithError(500, err)
+	} else {
+		c.Data(200, MediaType, doc)
+	}
+}
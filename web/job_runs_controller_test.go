@@ -15,6 +15,7 @@ import (
 	"github.com/smartcontractkit/chainlink/web"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	null "gopkg.in/guregu/null.v3"
 )
 
 type JobRunsJSON struct {
@@ -230,6 +231,45 @@ func TestJobRunsController_Update_Success(t *testing.T) {
 	assert.Equal(t, "100", val)
 }
 
+func TestJobRunsController_Update_TaskRun(t *testing.T) {
+	t.Parallel()
+	app, cleanup := cltest.NewApplication()
+	app.Start()
+	defer cleanup()
+
+	bt := cltest.NewBridgeType()
+	assert.Nil(t, app.Store.SaveBridgeType(&bt))
+	j, initr := cltest.NewJobWithWebInitiator()
+	j.Tasks = []models.TaskSpec{
+		{ID: "first", Type: bt.Name},
+		{ID: "second", Type: bt.Name},
+		{Type: "noop", DependsOn: []string{"first", "second"}},
+	}
+	assert.Nil(t, app.Store.SaveJob(&j))
+	jr := cltest.MarkJobRunPendingBridge(j.NewRun(initr), 0)
+	jr = cltest.MarkJobRunPendingBridge(jr, 1)
+	assert.Nil(t, app.Store.SaveJobRun(&jr))
+
+	headers := map[string]string{"Authorization": "Bearer " + bt.IncomingToken}
+	resume := func(taskRunID string, value string) int {
+		body := fmt.Sprintf(`{"id":"%v","data":{"value": "%v"}}`, jr.ID, value)
+		url := app.Config.ClientNodeURL() + "/v2/runs/" + jr.ID + "/task_runs/" + taskRunID
+		resp, cleanup := cltest.UnauthenticatedPatch(url, bytes.NewBufferString(body), headers)
+		defer cleanup()
+		return resp.StatusCode
+	}
+
+	assert.Equal(t, 200, resume(jr.TaskRuns[1].ID, "2"))
+	jr = cltest.WaitForJobRunToPendBridge(t, app.Store, jr)
+	assert.Equal(t, models.RunStatusPendingBridge, jr.TaskRuns[0].Status)
+	assert.Equal(t, 405, resume(jr.TaskRuns[1].ID, "3"))
+
+	assert.Equal(t, 200, resume(jr.TaskRuns[0].ID, "1"))
+	jr = cltest.WaitForJobRunToComplete(t, app.Store, jr)
+	assert.Equal(t, "1", jr.TaskRuns[0].Result.Data.Get("value").String())
+	assert.Equal(t, "2", jr.TaskRuns[1].Result.Data.Get("value").String())
+}
+
 func TestJobRunsController_Update_WrongAccessToken(t *testing.T) {
 	t.Parallel()
 	app, cleanup := cltest.NewApplication()
@@ -430,3 +470,129 @@ func TestJobRunsController_Show_Unauthenticated(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, 401, resp.StatusCode, "Response should be forbidden")
 }
+
+func TestJobRunsController_Cancel(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplication()
+	app.Start()
+	defer cleanup()
+	client := app.NewHTTPClient()
+
+	j, initr := cltest.NewJobWithWebInitiator()
+	j.Tasks = []models.TaskSpec{cltest.NewTask("noop"), cltest.NewTask("noop")}
+	assert.NoError(t, app.Store.SaveJob(&j))
+
+	jr := j.NewRun(initr)
+	jr.TaskRuns[0] = jr.TaskRuns[0].ApplyResult(models.RunResult{Status: models.RunStatusPendingBridge})
+	jr.Status = models.RunStatusPendingBridge
+	jr.Result.Status = models.RunStatusPendingBridge
+	assert.NoError(t, app.Store.SaveJobRun(&jr))
+
+	resp, cleanup := client.Post("/v2/runs/"+jr.ID+"/cancel", nil)
+	defer cleanup()
+	require.Equal(t, 200, resp.StatusCode, "Response should be successful")
+
+	var respJobRun presenters.JobRun
+	assert.NoError(t, cltest.ParseJSONAPIResponse(resp, &respJobRun))
+	assert.Equal(t, models.RunStatusCancelled, respJobRun.Status)
+
+	jr, err := app.Store.FindJobRun(jr.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, models.RunStatusCancelled, jr.Status)
+	for _, tr := range jr.TaskRuns {
+		assert.Equal(t, models.RunStatusCancelled, tr.Status)
+	}
+
+	resp, cleanup = client.Post("/v2/runs/"+jr.ID+"/cancel", nil)
+	defer cleanup()
+	assert.Equal(t, 409, resp.StatusCode, "Finished runs cannot be cancelled")
+}
+
+func TestJobRunsController_Resume(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplication()
+	app.Start()
+	defer cleanup()
+	client := app.NewHTTPClient()
+
+	j, initr := cltest.NewJobWithWebInitiator()
+	j.Tasks = []models.TaskSpec{cltest.NewTask("noop"), cltest.NewTask("noop")}
+	assert.NoError(t, app.Store.SaveJob(&j))
+
+	jr := j.NewRun(initr)
+	jr.TaskRuns[0] = jr.TaskRuns[0].ApplyResult(models.RunResult{Status: models.RunStatusCompleted})
+	jr.TaskRuns[1] = jr.TaskRuns[1].ApplyAttempt(models.RunResult{Status: models.RunStatusErrored, ErrorMessage: null.StringFrom("boom")}, time.Now(), time.Now())
+	jr = jr.ApplyResult(jr.TaskRuns[1].Result)
+	assert.NoError(t, app.Store.SaveJobRun(&jr))
+
+	resp, cleanup := client.Post("/v2/runs/"+jr.ID+"/resume", bytes.NewBufferString(`{"extra":"value"}`))
+	defer cleanup()
+	require.Equal(t, 200, resp.StatusCode, "Response should be successful")
+
+	jr = cltest.WaitForJobRunToComplete(t, app.Store, jr)
+	assert.Equal(t, models.RunStatusCompleted, jr.TaskRuns[1].Status)
+	assert.Equal(t, "value", jr.TaskRuns[1].Task.Params.Get("extra").String())
+	require.Len(t, jr.TaskRuns[1].Attempts, 2, "attempts made before resuming are kept")
+	assert.Equal(t, models.RunStatusErrored, jr.TaskRuns[1].Attempts[0].Status)
+	assert.Equal(t, models.RunStatusCompleted, jr.TaskRuns[1].Attempts[1].Status)
+	assert.Equal(t, 1, jr.TaskRuns[1].RetriesFrom)
+	require.Len(t, jr.Notes, 1)
+	assert.Contains(t, jr.Notes[0].Text, cltest.APIEmail)
+
+	resp, cleanup = client.Post("/v2/runs/"+jr.ID+"/resume", nil)
+	defer cleanup()
+	assert.Equal(t, 409, resp.StatusCode, "Only errored runs can be resumed")
+
+	resp, cleanup = client.Post("/v2/runs/bogus-ID/resume", nil)
+	defer cleanup()
+	assert.Equal(t, 404, resp.StatusCode)
+}
+
+func TestJobRunsController_Cancel_EthTxBroadcast(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplication()
+	app.Start()
+	defer cleanup()
+	client := app.NewHTTPClient()
+
+	j, initr := cltest.NewJobWithWebInitiator()
+	j.Tasks = []models.TaskSpec{cltest.NewTask("ethtx")}
+	assert.NoError(t, app.Store.SaveJob(&j))
+
+	hash := cltest.NewHash()
+	jr := j.NewRun(initr)
+	result := models.RunResult{}.WithValue(hash.Hex()).MarkPendingConfirmations()
+	jr.TaskRuns[0] = jr.TaskRuns[0].ApplyResult(result)
+	jr = jr.ApplyResult(result)
+	assert.NoError(t, app.Store.SaveJobRun(&jr))
+
+	resp, cleanup := client.Post("/v2/runs/"+jr.ID+"/cancel", nil)
+	defer cleanup()
+	assert.Equal(t, 409, resp.StatusCode, "Runs that broadcast a transaction are only cancelled with force")
+
+	resp, cleanup = client.Post("/v2/runs/"+jr.ID+"/cancel?force=true", nil)
+	defer cleanup()
+	require.Equal(t, 200, resp.StatusCode, "Response should be successful")
+
+	jr, err := app.Store.FindJobRun(jr.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, models.RunStatusCancelled, jr.Status)
+	require.Len(t, jr.Notes, 1)
+	assert.Contains(t, jr.Notes[0].Text, hash.Hex())
+}
+
+func TestJobRunsController_Cancel_NotFound(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplication()
+	app.Start()
+	defer cleanup()
+	client := app.NewHTTPClient()
+
+	resp, cleanup := client.Post("/v2/runs/garbage/cancel", nil)
+	defer cleanup()
+	assert.Equal(t, 404, resp.StatusCode, "Response should be not found")
+}
@@ -3,6 +3,8 @@ package web
 import (
 	"errors"
 	"fmt"
+	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/manyminds/api2go/jsonapi"
@@ -17,7 +19,7 @@ type JobSpecsController struct {
 	App services.Application
 }
 
-// Index lists JobSpecs, one page at a time.
+// Index lists JobSpecs that have not been archived, one page at a time.
 // Example:
 //  "<application>/specs?size=1&page=2"
 func (jsc *JobSpecsController) Index(c *gin.Context) {
@@ -34,9 +36,7 @@ func (jsc *JobSpecsController) Index(c *gin.Context) {
 		order = orm.Ascending
 	}
 
-	if count, err := jsc.App.GetStore().Count(&models.JobSpec{}); err != nil {
-		c.AbortWithError(500, fmt.Errorf("error getting count of JobSpec: %+v", err))
-	} else if jobs, err := jsc.App.GetStore().JobsSorted(order, offset, size); err != nil {
+	if jobs, count, err := jsc.App.GetStore().JobsSorted(order, offset, size); err != nil {
 		c.AbortWithError(500, fmt.Errorf("erorr fetching All JobSpecs: %+v", err))
 	} else {
 		pjs := make([]presenters.JobSpec, len(jobs))
@@ -89,6 +89,107 @@ func (jsc *JobSpecsController) Show(c *gin.Context) {
 	}
 }
 
+// Update validates and saves the next version of a JobSpec, replacing its
+// initiators, tasks and schedule.
+// Example:
+//  "<application>/specs/:SpecID"
+func (jsc *JobSpecsController) Update(c *gin.Context) {
+	id := c.Param("SpecID")
+	var jsr models.JobSpecRequest
+	if j, err := jsc.App.GetStore().FindJob(id); err == orm.ErrorNotFound {
+		publicError(c, 404, errors.New("JobSpec not found"))
+	} else if err != nil {
+		c.AbortWithError(500, err)
+	} else if j.Archived() {
+		publicError(c, 409, errors.New("Cannot update an archived JobSpec"))
+	} else if err := c.ShouldBindJSON(&jsr); err != nil {
+		publicError(c, 400, err)
+	} else if err := services.ValidateJob(j.Update(jsr, time.Now()), jsc.App.GetStore()); err != nil {
+		publicError(c, 400, err)
+	} else if err := jsc.App.UpdateJob(&j, jsr); err != nil {
+		c.AbortWithError(500, err)
+	} else if doc, err := jsonapi.Marshal(presenters.JobSpec{JobSpec: j}); err != nil {
+		c.AbortWithError(500, err)
+	} else {
+		c.Data(200, MediaType, doc)
+	}
+}
+
+// Destroy archives a JobSpec, stopping it from initiating runs while keeping
+// its run history.
+// Example:
+//  "<application>/specs/:SpecID"
+func (jsc *JobSpecsController) Destroy(c *gin.Context) {
+	jsc.changeStatus(c, models.JobSpec.Archived, jsc.App.ArchiveJob, "Cannot archive an archived JobSpec")
+}
+
+// Pause stops a JobSpec from initiating runs until it is resumed.
+// Example:
+//  "<application>/specs/:SpecID/pause"
+func (jsc *JobSpecsController) Pause(c *gin.Context) {
+	jsc.changeStatus(c, func(j models.JobSpec) bool { return !j.Active() }, jsc.App.PauseJob, "Only active JobSpecs can be paused")
+}
+
+// Resume lets a paused JobSpec initiate runs again.
+// Example:
+//  "<application>/specs/:SpecID/resume"
+func (jsc *JobSpecsController) Resume(c *gin.Context) {
+	jsc.changeStatus(c, func(j models.JobSpec) bool { return !j.Paused() }, jsc.App.ResumeJob, "Only paused JobSpecs can be resumed")
+}
+
+func (jsc *JobSpecsController) changeStatus(
+	c *gin.Context,
+	conflicts func(models.JobSpec) bool,
+	change func(*models.JobSpec) error,
+	conflictMessage string,
+) {
+	id := c.Param("SpecID")
+	if j, err := jsc.App.GetStore().FindJob(id); err == orm.ErrorNotFound {
+		publicError(c, 404, errors.New("JobSpec not found"))
+	} else if err != nil {
+		c.AbortWithError(500, err)
+	} else if conflicts(j) {
+		publicError(c, 409, errors.New(conflictMessage))
+	} else if err := change(&j); err != nil {
+		c.AbortWithError(500, err)
+	} else if doc, err := jsonapi.Marshal(presenters.JobSpec{JobSpec: j}); err != nil {
+		c.AbortWithError(500, err)
+	} else {
+		c.Data(200, MediaType, doc)
+	}
+}
+
+// ShowVersion returns the given version of a JobSpec, as it was before
+// being updated.
+// Example:
+//  "<application>/specs/:SpecID/versions/:Version"
+func (jsc *JobSpecsController) ShowVersion(c *gin.Context) {
+	id := c.Param("SpecID")
+	if version, err := strconv.ParseUint(c.Param("Version"), 10, 64); err != nil {
+		publicError(c, 422, fmt.Errorf("invalid version: %v", err))
+	} else if j, err := jsc.App.GetStore().FindJob(id); err == orm.ErrorNotFound {
+		publicError(c, 404, errors.New("JobSpec not found"))
+	} else if err != nil {
+		c.AbortWithError(500, err)
+	} else if version == j.Version {
+		jsc.renderVersion(c, j)
+	} else if jsv, err := jsc.App.GetStore().FindJobVersion(id, version); err == orm.ErrorNotFound {
+		publicError(c, 404, errors.New("JobSpec version not found"))
+	} else if err != nil {
+		c.AbortWithError(500, err)
+	} else {
+		jsc.renderVersion(c, jsv.Spec)
+	}
+}
+
+func (jsc *JobSpecsController) renderVersion(c *gin.Context, j models.JobSpec) {
+	if doc, err := jsonapi.Marshal(presenters.JobSpec{JobSpec: j}); err != nil {
+		c.AbortWithError(500, err)
+	} else {
+		c.Data(200, MediaType, doc)
+	}
+}
+
 func marshalSpecFromJSONAPI(j models.JobSpec, runs []models.JobRun) (*jsonapi.Document, error) {
 	pruns := make([]presenters.JobRun, len(runs))
 	for i, r := range runs {
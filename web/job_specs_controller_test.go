@@ -11,6 +11,7 @@ import (
 	"github.com/smartcontractkit/chainlink/adapters"
 	"github.com/smartcontractkit/chainlink/internal/cltest"
 	"github.com/smartcontractkit/chainlink/store/models"
+	"github.com/smartcontractkit/chainlink/store/orm"
 	"github.com/smartcontractkit/chainlink/store/presenters"
 	"github.com/smartcontractkit/chainlink/web"
 	"github.com/stretchr/testify/assert"
@@ -364,3 +365,116 @@ func TestJobSpecsController_Show_Unauthenticated(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, 401, resp.StatusCode, "Response should be forbidden")
 }
+
+func TestJobSpecsController_Update(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplication()
+	defer cleanup()
+	client := app.NewHTTPClient()
+
+	j, _ := cltest.NewJobWithWebInitiator()
+	require.NoError(t, app.AddJob(j))
+
+	body := `{"initiators":[{"type":"web"}],"tasks":[{"type":"NoOp"},{"type":"NoOp"}]}`
+	resp, cleanup := client.Patch("/v2/specs/"+j.ID, bytes.NewBufferString(body))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, 200)
+
+	var respJob presenters.JobSpec
+	require.NoError(t, cltest.ParseJSONAPIResponse(resp, &respJob))
+	assert.Equal(t, uint64(2), respJob.Version)
+	assert.Len(t, respJob.Tasks, 2)
+
+	resp, cleanup = client.Get("/v2/specs/" + j.ID + "/versions/1")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, 200)
+	var previous presenters.JobSpec
+	require.NoError(t, cltest.ParseJSONAPIResponse(resp, &previous))
+	assert.Equal(t, uint64(1), previous.Version)
+	assert.Len(t, previous.Tasks, 1)
+
+	initrs, err := app.Store.FindInitiatorsForJob(j.ID)
+	require.NoError(t, err)
+	assert.Len(t, initrs, 1, "should replace the previous initiators")
+
+	jr := cltest.CreateJobRunViaWeb(t, app, j)
+	assert.Equal(t, uint64(2), jr.JobVersion)
+
+	resp, cleanup = client.Patch("/v2/specs/"+j.ID, bytes.NewBufferString(`{"initiators":[],"tasks":[]}`))
+	defer cleanup()
+	assert.Equal(t, 400, resp.StatusCode, "should validate the new version")
+}
+
+func TestJobSpecsController_Destroy(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplication()
+	defer cleanup()
+	client := app.NewHTTPClient()
+
+	j, initr := cltest.NewJobWithWebInitiator()
+	require.NoError(t, app.AddJob(j))
+	jr := j.NewRun(initr)
+	require.NoError(t, app.Store.SaveJobRun(&jr))
+
+	resp, cleanup := client.Delete("/v2/specs/" + j.ID)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, 200)
+
+	j, err := app.Store.FindJob(j.ID)
+	require.NoError(t, err)
+	assert.True(t, j.Archived())
+	runs, err := app.Store.JobRunsFor(j.ID)
+	require.NoError(t, err)
+	assert.Len(t, runs, 1, "should keep the run history")
+
+	jobs, count, err := app.Store.JobsSorted(orm.Ascending, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 0, count)
+	assert.Len(t, jobs, 0)
+
+	resp, cleanup = client.Post("/v2/specs/"+j.ID+"/runs", nil)
+	defer cleanup()
+	assert.Equal(t, 409, resp.StatusCode, "archived jobs should not run")
+
+	resp, cleanup = client.Delete("/v2/specs/" + j.ID)
+	defer cleanup()
+	assert.Equal(t, 409, resp.StatusCode)
+}
+
+func TestJobSpecsController_PauseResume(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplication()
+	defer cleanup()
+	client := app.NewHTTPClient()
+
+	j, _ := cltest.NewJobWithWebInitiator()
+	require.NoError(t, app.AddJob(j))
+
+	resp, cleanup := client.Post("/v2/specs/"+j.ID+"/pause", nil)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, 200)
+	var respJob presenters.JobSpec
+	require.NoError(t, cltest.ParseJSONAPIResponse(resp, &respJob))
+	assert.Equal(t, models.JobSpecStatusPaused, respJob.Status)
+
+	resp, cleanup = client.Post("/v2/specs/"+j.ID+"/runs", nil)
+	defer cleanup()
+	assert.Equal(t, 409, resp.StatusCode, "paused jobs should not run")
+
+	resp, cleanup = client.Post("/v2/specs/"+j.ID+"/pause", nil)
+	defer cleanup()
+	assert.Equal(t, 409, resp.StatusCode)
+
+	resp, cleanup = client.Post("/v2/specs/"+j.ID+"/resume", nil)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, 200)
+	require.NoError(t, cltest.ParseJSONAPIResponse(resp, &respJob))
+	assert.Equal(t, models.JobSpecStatusActive, respJob.Status)
+
+	resp, cleanup = client.Post("/v2/specs/"+j.ID+"/runs", nil)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, 200)
+}
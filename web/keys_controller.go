@@ -1,10 +1,17 @@
 package web
 
 import (
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/gin-gonic/gin"
 	"github.com/manyminds/api2go/jsonapi"
 	"github.com/smartcontractkit/chainlink/services"
+	strpkg "github.com/smartcontractkit/chainlink/store"
 	"github.com/smartcontractkit/chainlink/store/models"
+	"github.com/smartcontractkit/chainlink/store/orm"
 	"github.com/smartcontractkit/chainlink/store/presenters"
 )
 
@@ -13,21 +20,187 @@ type KeysController struct {
 	App services.Application
 }
 
-// Create adds a new account
+// Index returns the accounts in the keystore with their ETH & LINK balances
+// and whether they are kept from sending new transactions.
+// Example:
+//  "<application>/keys"
+func (c *KeysController) Index(ctx *gin.Context) {
+	store := c.App.GetStore()
+	keys := []presenters.Key{}
+	for _, a := range store.KeyStore.Accounts() {
+		balance := getAccountBalanceFor(ctx, store, a)
+		if ctx.IsAborted() {
+			return
+		}
+		key, err := store.FindKey(a.Address)
+		if err != nil && err != orm.ErrorNotFound {
+			ctx.AbortWithError(500, err)
+			return
+		}
+		keys = append(keys, presenters.Key{AccountBalance: balance, SendDisabled: key.SendDisabled})
+	}
+
+	if json, err := jsonapi.Marshal(keys); err != nil {
+		ctx.AbortWithError(500, fmt.Errorf("failed to marshal keys using jsonapi: %+v", err))
+	} else {
+		ctx.Data(200, MediaType, json)
+	}
+}
+
+// Create adds a new account, encrypted with the keystore password. A
+// different new account password is refused.
 // Example:
 //  "<application>/keys"
 func (c *KeysController) Create(ctx *gin.Context) {
 	request := models.CreateKeyRequest{}
+	store := c.App.GetStore()
 
 	if err := ctx.ShouldBindJSON(&request); err != nil {
 		publicError(ctx, 422, err)
-	} else if err := c.App.GetStore().KeyStore.Unlock(request.CurrentPassword); err != nil {
+	} else if err := store.KeyStore.Unlock(request.CurrentPassword); err != nil {
 		publicError(ctx, 401, err)
-	} else if account, err := c.App.GetStore().KeyStore.NewAccount(request.NewAccountPassword); err != nil {
+	} else if request.NewAccountPassword != "" && request.NewAccountPassword != request.CurrentPassword {
+		publicError(ctx, 422, errors.New("new keys must be encrypted with the keystore password, which unlocks every key at startup"))
+	} else if account, err := store.KeyStore.NewAccount(request.CurrentPassword); err != nil {
 		ctx.AbortWithError(500, err)
-	} else if doc, err := jsonapi.Marshal(&presenters.NewAccount{&account}); err != nil {
+	} else {
+		addAccount(ctx, c.App, account)
+	}
+}
+
+// Import adds the account in an encrypted JSON key, re-encrypting it with
+// the keystore password.
+// Example:
+//  "<application>/key_imports"
+func (c *KeysController) Import(ctx *gin.Context) {
+	request := models.ImportKeyRequest{}
+	store := c.App.GetStore()
+
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		publicError(ctx, 422, err)
+	} else if err := store.KeyStore.Unlock(request.CurrentPassword); err != nil {
+		publicError(ctx, 401, err)
+	} else if account, err := store.KeyStore.Import(request.KeyJSON, request.KeyPassword, request.CurrentPassword); err != nil {
+		publicError(ctx, 422, fmt.Errorf("unable to import key: %v", err))
+	} else {
+		addAccount(ctx, c.App, account)
+	}
+}
+
+// addAccount hands an account added to the keystore to the transaction
+// manager, so that it is used without restarting the node.
+func addAccount(ctx *gin.Context, app services.Application, account accounts.Account) {
+	app.GetStore().TxManager.AddAccount(account)
+	if doc, err := jsonapi.Marshal(&presenters.NewAccount{&account}); err != nil {
 		ctx.AbortWithError(500, err)
 	} else {
 		ctx.Data(201, MediaType, doc)
 	}
 }
+
+// Export returns the key of an account as JSON, encrypted with the new
+// password in the body, or the keystore password if omitted.
+// Example:
+//  "<application>/keys/:Address/export"
+func (c *KeysController) Export(ctx *gin.Context) {
+	request := models.ExportKeyRequest{}
+	store := c.App.GetStore()
+
+	address, ok := findKeyAddress(ctx, c.App)
+	if !ok {
+		return
+	}
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		publicError(ctx, 422, err)
+		return
+	}
+	if request.NewPassword == "" {
+		request.NewPassword = request.CurrentPassword
+	}
+
+	if err := store.KeyStore.Unlock(request.CurrentPassword); err != nil {
+		publicError(ctx, 401, err)
+	} else if keyJSON, err := store.KeyStore.Export(address, request.CurrentPassword, request.NewPassword); err != nil {
+		ctx.AbortWithError(500, err)
+	} else {
+		ctx.Data(200, "application/json", keyJSON)
+	}
+}
+
+// Update changes whether an account is kept from sending new transactions.
+// Example:
+//  "<application>/keys/:Address"
+func (c *KeysController) Update(ctx *gin.Context) {
+	request := models.UpdateKeyRequest{}
+	store := c.App.GetStore()
+
+	address, ok := findKeyAddress(ctx, c.App)
+	if !ok {
+		return
+	}
+
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		publicError(ctx, 422, err)
+	} else if err := store.KeyStore.Unlock(request.CurrentPassword); err != nil {
+		publicError(ctx, 401, err)
+	} else if err := store.TxManager.SetSendDisabled(address, request.SendDisabled); err != nil {
+		ctx.AbortWithError(500, err)
+	} else if doc, err := jsonapi.Marshal(&models.Key{Address: address, SendDisabled: request.SendDisabled}); err != nil {
+		ctx.AbortWithError(500, err)
+	} else {
+		ctx.Data(200, MediaType, doc)
+	}
+}
+
+// Destroy removes an account's key from the keystore. The node's first key,
+// which it signs with, and keys with unconfirmed transactions are refused.
+// Example:
+//  "<application>/keys/:Address"
+func (c *KeysController) Destroy(ctx *gin.Context) {
+	request := models.DeleteKeyRequest{}
+	store := c.App.GetStore()
+
+	address, ok := findKeyAddress(ctx, c.App)
+	if !ok {
+		return
+	}
+
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		publicError(ctx, 422, err)
+	} else if err := store.KeyStore.Unlock(request.CurrentPassword); err != nil {
+		publicError(ctx, 401, err)
+	} else if first, err := store.KeyStore.GetFirstAccount(); err != nil {
+		ctx.AbortWithError(500, err)
+	} else if first.Address == address {
+		publicError(ctx, 409, errors.New("cannot delete the node's first key, which it signs with"))
+	} else if err := store.TxManager.RemoveAccount(address); err == strpkg.ErrUnconfirmedTxs {
+		publicError(ctx, 409, err)
+	} else if err != nil {
+		ctx.AbortWithError(500, err)
+	} else if err := store.KeyStore.Delete(address, request.CurrentPassword); err != nil {
+		store.TxManager.AddAccount(accounts.Account{Address: address})
+		ctx.AbortWithError(500, err)
+	} else if err := store.DeleteKey(address); err != nil {
+		ctx.AbortWithError(500, err)
+	} else if doc, err := jsonapi.Marshal(&models.Key{Address: address}); err != nil {
+		ctx.AbortWithError(500, err)
+	} else {
+		ctx.Data(200, MediaType, doc)
+	}
+}
+
+// findKeyAddress returns the address in the path if it is of an account in
+// the keystore, aborting the request otherwise.
+func findKeyAddress(ctx *gin.Context, app services.Application) (common.Address, bool) {
+	param := ctx.Param("Address")
+	if !common.IsHexAddress(param) {
+		publicError(ctx, 422, fmt.Errorf("invalid address %s", param))
+		return common.Address{}, false
+	}
+	address := common.HexToAddress(param)
+	if _, err := app.GetStore().KeyStore.Find(accounts.Account{Address: address}); err != nil {
+		publicError(ctx, 404, fmt.Errorf("no key for address %s", address.Hex()))
+		return common.Address{}, false
+	}
+	return address, true
+}
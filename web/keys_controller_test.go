@@ -7,7 +7,9 @@ import (
 
 	"github.com/smartcontractkit/chainlink/internal/cltest"
 	"github.com/smartcontractkit/chainlink/store/models"
+	"github.com/smartcontractkit/chainlink/store/presenters"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestKeysController_CreateSuccess(t *testing.T) {
@@ -27,7 +29,7 @@ func TestKeysController_CreateSuccess(t *testing.T) {
 
 	request := models.CreateKeyRequest{
 		CurrentPassword:    cltest.Password,
-		NewAccountPassword: "kwyjibo",
+		NewAccountPassword: cltest.Password,
 	}
 
 	body, err := json.Marshal(&request)
@@ -37,6 +39,7 @@ func TestKeysController_CreateSuccess(t *testing.T) {
 	defer cleanup()
 
 	cltest.AssertServerResponse(t, resp, 201)
+	assert.Len(t, app.Store.KeyStore.Accounts(), 2)
 
 	ethMock.AllCalled()
 }
@@ -71,6 +74,38 @@ func TestKeysController_InvalidPassword(t *testing.T) {
 	ethMock.AllCalled()
 }
 
+func TestKeysController_CreateWithDifferentPassword(t *testing.T) {
+	config, _ := cltest.NewConfig()
+	app, cleanup := cltest.NewApplicationWithConfigAndKeyStore(config)
+	defer cleanup()
+
+	ethMock := app.MockEthClient()
+	ethMock.Context("app.Start()", func(ethMock *cltest.EthMock) {
+		ethMock.Register("eth_getTransactionCount", "0x100")
+		ethMock.Register("eth_getBlockByNumber", models.BlockHeader{})
+	})
+
+	client := app.NewHTTPClient()
+
+	assert.NoError(t, app.StartAndConnect())
+
+	request := models.CreateKeyRequest{
+		CurrentPassword:    cltest.Password,
+		NewAccountPassword: "kwyjibo",
+	}
+
+	body, err := json.Marshal(&request)
+	assert.NoError(t, err)
+
+	resp, cleanup := client.Post("/v2/keys", bytes.NewBuffer(body))
+	defer cleanup()
+
+	cltest.AssertServerResponse(t, resp, 422)
+	assert.Len(t, app.Store.KeyStore.Accounts(), 1)
+
+	ethMock.AllCalled()
+}
+
 func TestKeysController_JSONBindingError(t *testing.T) {
 	config, _ := cltest.NewConfig()
 	app, cleanup := cltest.NewApplicationWithConfigAndKeyStore(config)
@@ -93,3 +128,168 @@ func TestKeysController_JSONBindingError(t *testing.T) {
 
 	ethMock.AllCalled()
 }
+
+func TestKeysController_Index(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplicationWithKeyStore()
+	defer cleanup()
+	client := app.NewHTTPClient()
+	address := cltest.GetAccountAddress(app.Store)
+	require.NoError(t, app.Store.SaveKey(&models.Key{Address: address, SendDisabled: true}))
+
+	ethMock := app.MockEthClient()
+	ethMock.Register("eth_getBalance", "0x0100")
+	ethMock.Register("eth_call", "0x01")
+
+	resp, cleanup := client.Get("/v2/keys")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, 200)
+
+	keys := []presenters.Key{}
+	require.NoError(t, cltest.ParseJSONAPIResponse(resp, &keys))
+	require.Len(t, keys, 1)
+	assert.Equal(t, address.Hex(), keys[0].Address)
+	assert.Equal(t, "0.000000000000000256", keys[0].EthBalance.String())
+	assert.Equal(t, "0.000000000000000001", keys[0].LinkBalance.String())
+	assert.True(t, keys[0].SendDisabled)
+}
+
+func TestKeysController_Update(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplicationWithKeyStore()
+	defer cleanup()
+	ethMock := app.MockEthClient()
+	ethMock.Context("app.StartAndConnect()", func(ethMock *cltest.EthMock) {
+		ethMock.Register("eth_getTransactionCount", "0x100")
+	})
+	require.NoError(t, app.StartAndConnect())
+
+	client := app.NewHTTPClient()
+	address := cltest.GetAccountAddress(app.Store)
+
+	tests := []struct {
+		name    string
+		address string
+		body    string
+		status  int
+	}{
+		{"wrong password", address.Hex(), `{"current_password":"wrong","send_disabled":true}`, 401},
+		{"unknown key", cltest.NewAddress().Hex(), `{"current_password":"password","send_disabled":true}`, 404},
+		{"invalid address", "0xnope", `{"current_password":"password","send_disabled":true}`, 422},
+		{"disabled", address.Hex(), `{"current_password":"password","send_disabled":true}`, 200},
+	}
+
+	for _, test := range tests {
+		resp, cleanup := client.Patch("/v2/keys/"+test.address, bytes.NewBufferString(test.body))
+		defer cleanup()
+		cltest.AssertServerResponse(t, resp, test.status)
+	}
+
+	key, err := app.Store.FindKey(address)
+	require.NoError(t, err)
+	assert.True(t, key.SendDisabled)
+	assert.Nil(t, app.Store.TxManager.NextActiveAccount())
+}
+
+func TestKeysController_ExportImport(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplicationWithKeyStore()
+	defer cleanup()
+	client := app.NewHTTPClient()
+	address := cltest.GetAccountAddress(app.Store)
+
+	resp, cleanup := client.Post("/v2/keys/"+address.Hex()+"/export", bytes.NewBufferString(`{"current_password":"wrong"}`))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, 401)
+
+	resp, cleanup = client.Post("/v2/keys/"+address.Hex()+"/export", bytes.NewBufferString(`{"current_password":"password","new_password":"exported"}`))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, 200)
+	keyJSON := cltest.ParseResponseBody(resp)
+
+	other, otherCleanup := cltest.NewApplication()
+	defer otherCleanup()
+	otherClient := other.NewHTTPClient()
+
+	request := models.ImportKeyRequest{
+		CurrentPassword: cltest.Password,
+		KeyJSON:         keyJSON,
+		KeyPassword:     "wrong",
+	}
+	body, err := json.Marshal(&request)
+	require.NoError(t, err)
+	resp, cleanup = otherClient.Post("/v2/key_imports", bytes.NewBuffer(body))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, 422)
+
+	request.KeyPassword = "exported"
+	body, err = json.Marshal(&request)
+	require.NoError(t, err)
+	resp, cleanup = otherClient.Post("/v2/key_imports", bytes.NewBuffer(body))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, 201)
+
+	accounts := other.Store.KeyStore.Accounts()
+	require.Len(t, accounts, 1)
+	assert.Equal(t, address, accounts[0].Address)
+	assert.NoError(t, other.Store.KeyStore.Unlock(cltest.Password))
+}
+
+func TestKeysController_Delete(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplicationWithKeyStore()
+	defer cleanup()
+	ethMock := app.MockEthClient()
+	ethMock.Context("app.StartAndConnect()", func(ethMock *cltest.EthMock) {
+		ethMock.Register("eth_getTransactionCount", "0x100")
+	})
+	require.NoError(t, app.StartAndConnect())
+
+	store := app.Store
+	client := app.NewHTTPClient()
+	first := cltest.GetAccountAddress(store)
+	account, err := store.KeyStore.NewAccount(cltest.Password)
+	require.NoError(t, err)
+	ethMock.Register("eth_getTransactionCount", "0x0")
+	store.TxManager.AddAccount(account)
+
+	tx := cltest.CreateTxAndAttempt(store, account.Address, 1)
+
+	tests := []struct {
+		name    string
+		address string
+		body    string
+		status  int
+	}{
+		{"wrong password", account.Address.Hex(), `{"current_password":"wrong"}`, 401},
+		{"unknown key", cltest.NewAddress().Hex(), `{"current_password":"password"}`, 404},
+		{"first key", first.Hex(), `{"current_password":"password"}`, 409},
+		{"unconfirmed transactions", account.Address.Hex(), `{"current_password":"password"}`, 409},
+	}
+
+	for _, test := range tests {
+		resp, cleanup := client.Delete("/v2/keys/"+test.address, bytes.NewBufferString(test.body))
+		defer cleanup()
+		cltest.AssertServerResponse(t, resp, test.status)
+	}
+	require.Len(t, store.KeyStore.Accounts(), 2)
+
+	attempts, err := store.TxAttemptsFor(tx.ID)
+	require.NoError(t, err)
+	require.NoError(t, store.ConfirmTx(tx, &attempts[0]))
+
+	resp, cleanup := client.Delete("/v2/keys/"+account.Address.Hex(), bytes.NewBufferString(`{"current_password":"password"}`))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, 200)
+
+	accounts := store.KeyStore.Accounts()
+	require.Len(t, accounts, 1)
+	assert.Equal(t, first, accounts[0].Address)
+	for i := 0; i < 2; i++ {
+		assert.Equal(t, first, store.TxManager.NextActiveAccount().Address)
+	}
+}
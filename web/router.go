@@ -30,6 +30,8 @@ const (
 	SessionName = "clsession"
 	// SessionIDKey is the session ID key in the session map
 	SessionIDKey = "clsession_id"
+	// SessionUserKey is the context key of the authenticated user
+	SessionUserKey = "clsession_user"
 )
 
 // Router listens and responds to requests to the node for valid paths.
@@ -55,6 +57,7 @@ func Router(app services.Application) *gin.Engine {
 
 	metricRoutes(app, engine)
 	sessionRoutes(app, engine)
+	healthRoutes(app, engine)
 	v1Routes(app, engine)
 	v2Routes(app, engine)
 	guiAssetRoutes(app.NewBox(), engine)
@@ -103,9 +106,10 @@ func authRequired(store *store.Store) gin.HandlerFunc {
 		sessionID, ok := session.Get(SessionIDKey).(string)
 		if !ok {
 			c.AbortWithStatus(http.StatusUnauthorized)
-		} else if _, err := store.AuthorizedUserWithSession(sessionID); err != nil {
+		} else if user, err := store.AuthorizedUserWithSession(sessionID); err != nil {
 			c.AbortWithStatus(http.StatusUnauthorized)
 		} else {
+			c.Set(SessionUserKey, user)
 			c.Next()
 		}
 	}
@@ -123,6 +127,11 @@ func sessionRoutes(app services.Application, engine *gin.Engine) {
 	auth.DELETE("/sessions", sc.Destroy)
 }
 
+func healthRoutes(app services.Application, engine *gin.Engine) {
+	hc := HealthController{app}
+	engine.GET("/health", hc.Show)
+}
+
 func v1Routes(app services.Application, engine *gin.Engine) {
 	v1 := engine.Group("/v1")
 	v1.Use(authRequired(app.GetStore()))
@@ -141,6 +150,7 @@ func v2Routes(app services.Application, engine *gin.Engine) {
 
 	jr := JobRunsController{app}
 	v2.PATCH("/runs/:RunID", jr.Update)
+	v2.PATCH("/runs/:RunID/task_runs/:TaskRunID", jr.Update)
 
 	sa := ServiceAgreementsController{app}
 	v2.POST("/service_agreements", sa.Create)
@@ -155,10 +165,20 @@ func v2Routes(app services.Application, engine *gin.Engine) {
 		authv2.GET("/specs", j.Index)
 		authv2.POST("/specs", j.Create)
 		authv2.GET("/specs/:SpecID", j.Show)
+		authv2.PATCH("/specs/:SpecID", j.Update)
+		authv2.DELETE("/specs/:SpecID", j.Destroy)
+		authv2.POST("/specs/:SpecID/pause", j.Pause)
+		authv2.POST("/specs/:SpecID/resume", j.Resume)
+		authv2.GET("/specs/:SpecID/versions/:Version", j.ShowVersion)
+
+		sim := SimulationsController{app}
+		authv2.POST("/simulations", sim.Create)
 
 		authv2.GET("/runs", jr.Index)
 		authv2.POST("/specs/:SpecID/runs", jr.Create)
 		authv2.GET("/runs/:RunID", jr.Show)
+		authv2.POST("/runs/:RunID/cancel", jr.Cancel)
+		authv2.POST("/runs/:RunID/resume", jr.Resume)
 
 		authv2.GET("/service_agreements/:SAID", sa.Show)
 
@@ -175,10 +195,18 @@ func v2Routes(app services.Application, engine *gin.Engine) {
 		ts := TransfersController{app}
 		authv2.POST("/transfers", ts.Create)
 
-		if app.GetStore().Config.Dev() {
-			kc := KeysController{app}
-			authv2.POST("/keys", kc.Create)
-		}
+		kc := KeysController{app}
+		authv2.GET("/keys", kc.Index)
+		authv2.POST("/keys", kc.Create)
+		authv2.PATCH("/keys/:Address", kc.Update)
+		authv2.POST("/keys/:Address/export", kc.Export)
+		authv2.DELETE("/keys/:Address", kc.Destroy)
+		authv2.POST("/key_imports", kc.Import)
+
+		secrets := SecretsController{app}
+		authv2.GET("/secrets", secrets.Index)
+		authv2.POST("/secrets", secrets.Create)
+		authv2.DELETE("/secrets/:Name", secrets.Destroy)
 
 		backup := BackupController{app}
 		authv2.GET("/backup", backup.Show)
@@ -189,9 +217,33 @@ func v2Routes(app services.Application, engine *gin.Engine) {
 		txs := TxAttemptsController{app}
 		authv2.GET("/txattempts", txs.Index)
 
+		rqc := RunQueueController{app}
+		authv2.GET("/run_queue", rqc.Show)
+
+		enc := EthNodesController{app}
+		authv2.GET("/eth_nodes", enc.Index)
+
+		gpc := GasPriceController{app}
+		authv2.GET("/gas_price", gpc.Show)
+
+		trc := TxReconciliationsController{app}
+		authv2.GET("/tx_reconciliations", trc.Index)
+
+		ebc := EthBalancesController{app}
+		authv2.GET("/eth_balances", ebc.Index)
+
+		tc := TransactionsController{app}
+		authv2.GET("/transactions", tc.Index)
+		authv2.POST("/transactions/:TxHash/speed_up", tc.SpeedUp)
+		authv2.POST("/transactions/:TxHash/cancel", tc.Cancel)
+
 		bdc := BulkDeletesController{app}
 		authv2.POST("/bulk_delete_runs", bdc.Create)
 		authv2.GET("/bulk_delete_runs/:taskID", bdc.Show)
+
+		bbc := BulkBackfillsController{app}
+		authv2.POST("/bulk_backfill_logs", bbc.Create)
+		authv2.GET("/bulk_backfill_logs/:taskID", bbc.Show)
 	}
 }
 
@@ -339,6 +391,7 @@ var blacklist = map[string]struct{}{
 	"oldpassword":          struct{}{},
 	"current_password":     struct{}{},
 	"new_account_password": struct{}{},
+	"secret":               struct{}{},
 }
 
 func isBlacklisted(k string) bool {
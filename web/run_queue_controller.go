@@ -0,0 +1,27 @@
+package web
+
+import (
+	"fmt"
+
+	"github.com/gin-gonic/gin"
+	"github.com/manyminds/api2go/jsonapi"
+	"github.com/smartcontractkit/chainlink/services"
+)
+
+// RunQueueController exposes the state of the job runner's queue.
+type RunQueueController struct {
+	App services.Application
+}
+
+// Show returns the number of runs executing and waiting in the run queue,
+// overall and per job, along with their wait times.
+// Example:
+//  "<application>/run_queue"
+func (rqc *RunQueueController) Show(c *gin.Context) {
+	stats := rqc.App.RunQueueStats()
+	if json, err := jsonapi.Marshal(stats); err != nil {
+		c.AbortWithError(500, fmt.Errorf("failed to marshal run queue using jsonapi: %+v", err))
+	} else {
+		c.Data(200, MediaType, json)
+	}
+}
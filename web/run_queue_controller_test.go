@@ -0,0 +1,30 @@
+package web_test
+
+import (
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/internal/cltest"
+	"github.com/smartcontractkit/chainlink/store/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunQueueController_Show(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplication()
+	defer cleanup()
+	app.Store.Config.Set("MAX_CONCURRENT_RUNS", 5)
+	client := app.NewHTTPClient()
+
+	resp, cleanup := client.Get("/v2/run_queue")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, 200)
+
+	var stats models.RunQueueStats
+	require.NoError(t, cltest.ParseJSONAPIResponse(resp, &stats))
+	assert.Equal(t, uint64(5), stats.MaxConcurrentRuns)
+	assert.Equal(t, 0, stats.Executing)
+	assert.Equal(t, 0, stats.Depth)
+	assert.Len(t, stats.Jobs, 0)
+}
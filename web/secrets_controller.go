@@ -0,0 +1,75 @@
+package web
+
+import (
+	"errors"
+
+	"github.com/gin-gonic/gin"
+	"github.com/manyminds/api2go/jsonapi"
+	"github.com/smartcontractkit/chainlink/services"
+	"github.com/smartcontractkit/chainlink/store/models"
+	"github.com/smartcontractkit/chainlink/store/orm"
+	"github.com/smartcontractkit/chainlink/store/presenters"
+)
+
+// SecretsController manages the secrets referenced from task params. The
+// values of secrets are never returned.
+type SecretsController struct {
+	App services.Application
+}
+
+// Index lists the names of the secrets.
+// Example:
+//  "<application>/secrets"
+func (sc *SecretsController) Index(c *gin.Context) {
+	secrets, err := sc.App.GetStore().Secrets()
+	if err != nil {
+		c.AbortWithError(500, err)
+		return
+	}
+
+	ps := make([]presenters.Secret, len(secrets))
+	for i, s := range secrets {
+		ps[i] = presenters.NewSecret(s)
+	}
+	if doc, err := jsonapi.Marshal(ps); err != nil {
+		c.AbortWithError(500, err)
+	} else {
+		c.Data(200, MediaType, doc)
+	}
+}
+
+// Create encrypts and stores a secret, replacing any secret of the same name.
+// Example:
+//  "<application>/secrets"
+func (sc *SecretsController) Create(c *gin.Context) {
+	request := models.SecretRequest{}
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		publicError(c, 422, err)
+	} else if secret, err := services.SaveSecret(request, sc.App.GetStore()); err != nil {
+		publicError(c, StatusCodeForError(err), err)
+	} else if doc, err := jsonapi.Marshal(presenters.NewSecret(secret)); err != nil {
+		c.AbortWithError(500, err)
+	} else {
+		c.Data(201, MediaType, doc)
+	}
+}
+
+// Destroy removes a secret.
+// Example:
+//  "<application>/secrets/:Name"
+func (sc *SecretsController) Destroy(c *gin.Context) {
+	name := c.Param("Name")
+	store := sc.App.GetStore()
+	if secret, err := store.FindSecret(name); err == orm.ErrorNotFound {
+		publicError(c, 404, errors.New("secret not found"))
+	} else if err != nil {
+		c.AbortWithError(500, err)
+	} else if err := store.DeleteSecret(name); err != nil {
+		c.AbortWithError(500, err)
+	} else if doc, err := jsonapi.Marshal(presenters.NewSecret(secret)); err != nil {
+		c.AbortWithError(500, err)
+	} else {
+		c.Data(200, MediaType, doc)
+	}
+}
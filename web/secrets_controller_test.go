@@ -0,0 +1,77 @@
+package web_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/internal/cltest"
+	"github.com/smartcontractkit/chainlink/store/presenters"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecretsController_Create(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplicationWithKeyStore()
+	defer cleanup()
+	client := app.NewHTTPClient()
+
+	resp, cleanup := client.Post("/v2/secrets", bytes.NewBufferString(`{"name":"cmc_key","secret":"s3cr3t"}`))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, 201)
+
+	body := cltest.ParseResponseBody(resp)
+	assert.NotContains(t, string(body), "s3cr3t")
+
+	secret, err := app.Store.FindSecret("cmc_key")
+	require.NoError(t, err)
+	assert.NotContains(t, string(secret.Ciphertext), "s3cr3t")
+}
+
+func TestSecretsController_Create_InvalidName(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplicationWithKeyStore()
+	defer cleanup()
+	client := app.NewHTTPClient()
+
+	resp, cleanup := client.Post("/v2/secrets", bytes.NewBufferString(`{"name":"cmc key","secret":"s3cr3t"}`))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, 400)
+}
+
+func TestSecretsController_IndexAndDestroy(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplicationWithKeyStore()
+	defer cleanup()
+	client := app.NewHTTPClient()
+
+	for _, name := range []string{"b_key", "a_key"} {
+		resp, cleanup := client.Post("/v2/secrets", bytes.NewBufferString(`{"name":"`+name+`","secret":"s3cr3t"}`))
+		defer cleanup()
+		cltest.AssertServerResponse(t, resp, 201)
+	}
+
+	resp, cleanup := client.Get("/v2/secrets")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, 200)
+
+	var secrets []presenters.Secret
+	require.NoError(t, cltest.ParseJSONAPIResponse(resp, &secrets))
+	require.Len(t, secrets, 2)
+	assert.Equal(t, "a_key", secrets[0].Name)
+	assert.Equal(t, "b_key", secrets[1].Name)
+
+	resp, cleanup = client.Delete("/v2/secrets/a_key")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, 200)
+
+	_, err := app.Store.FindSecret("a_key")
+	assert.Error(t, err)
+
+	resp, cleanup = client.Delete("/v2/secrets/a_key")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, 404)
+}
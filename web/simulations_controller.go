@@ -0,0 +1,38 @@
+package web
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/manyminds/api2go/jsonapi"
+	"github.com/smartcontractkit/chainlink/adapters"
+	"github.com/smartcontractkit/chainlink/services"
+	"github.com/smartcontractkit/chainlink/store/models"
+)
+
+// SimulationsController executes job specs without saving them.
+type SimulationsController struct {
+	App services.Application
+}
+
+// Create validates a JobSpec and executes its tasks in memory against the
+// sample input, returning the input and output of each task.
+// Example:
+//  "<application>/simulations"
+func (sc *SimulationsController) Create(c *gin.Context) {
+	var sr models.SimulationRequest
+	if err := c.ShouldBindJSON(&sr); err != nil {
+		publicError(c, 400, err)
+		return
+	}
+
+	js := models.NewJobFromRequest(sr.Spec)
+	opts := adapters.SimulationOptions{EthCall: sr.EthCall, From: sr.From}
+	if err := services.ValidateJob(js, sc.App.GetStore()); err != nil {
+		publicError(c, 400, err)
+	} else if sim, err := services.SimulateJob(js, models.RunResult{Data: sr.Input}, opts, sc.App.GetStore()); err != nil {
+		c.AbortWithError(500, err)
+	} else if doc, err := jsonapi.Marshal(sim); err != nil {
+		c.AbortWithError(500, err)
+	} else {
+		c.Data(200, MediaType, doc)
+	}
+}
@@ -0,0 +1,53 @@
+package web_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/internal/cltest"
+	"github.com/smartcontractkit/chainlink/store/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSimulationsController_Create(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplication()
+	defer cleanup()
+	client := app.NewHTTPClient()
+
+	body := `{
+		"spec": {"initiators":[{"type":"web"}],"tasks":[{"type":"multiply","times":100},{"type":"noop"}]},
+		"input": {"value":"1.5"}
+	}`
+	resp, cleanup := client.Post("/v2/simulations", bytes.NewBufferString(body))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, 200)
+
+	var sim models.JobSimulation
+	require.NoError(t, cltest.ParseJSONAPIResponse(resp, &sim))
+	assert.Equal(t, models.RunStatusCompleted, sim.Status)
+	assert.Len(t, sim.Tasks, 2)
+	assert.Equal(t, "150", sim.Result.Data.Get("value").String())
+
+	count, err := app.Store.JobRunsCount()
+	require.NoError(t, err)
+	assert.Equal(t, 0, count)
+	count, err = app.Store.Count(&models.JobSpec{})
+	require.NoError(t, err)
+	assert.Equal(t, 0, count)
+}
+
+func TestSimulationsController_Create_InvalidSpec(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplication()
+	defer cleanup()
+	client := app.NewHTTPClient()
+
+	body := `{"spec": {"initiators":[{"type":"web"}],"tasks":[{"type":"idonotexist"}]}}`
+	resp, cleanup := client.Post("/v2/simulations", bytes.NewBufferString(body))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, 400)
+}
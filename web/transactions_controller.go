@@ -0,0 +1,137 @@
+package web
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gin-gonic/gin"
+	"github.com/manyminds/api2go/jsonapi"
+	"github.com/smartcontractkit/chainlink/services"
+	"github.com/smartcontractkit/chainlink/store"
+	"github.com/smartcontractkit/chainlink/store/models"
+	"github.com/smartcontractkit/chainlink/store/orm"
+	"github.com/smartcontractkit/chainlink/store/presenters"
+)
+
+// TransactionsController lists the node's transactions, and speeds up or
+// cancels those which have not yet been confirmed.
+type TransactionsController struct {
+	App services.Application
+}
+
+// Index returns paginated transactions, newest first, optionally filtered
+// by status (confirmed or unconfirmed) and sending account.
+// Example:
+//  "<application>/transactions?status=unconfirmed&account=0x..."
+func (tc *TransactionsController) Index(c *gin.Context) {
+	size, page, offset, err := ParsePaginatedRequest(c.Query("size"), c.Query("page"))
+	if err != nil {
+		c.AbortWithError(422, err)
+		return
+	}
+
+	var confirmed *bool
+	switch status := c.Query("status"); status {
+	case "":
+	case "confirmed", "unconfirmed":
+		value := status == "confirmed"
+		confirmed = &value
+	default:
+		c.AbortWithError(422, fmt.Errorf("invalid status %s, must be confirmed or unconfirmed", status))
+		return
+	}
+
+	var from *common.Address
+	if account := c.Query("account"); account != "" {
+		if !common.IsHexAddress(account) {
+			c.AbortWithError(422, fmt.Errorf("invalid account %s", account))
+			return
+		}
+		address := common.HexToAddress(account)
+		from = &address
+	}
+
+	txs, count, err := tc.App.GetStore().TxsFiltered(from, confirmed, offset, size)
+	if err != nil {
+		c.AbortWithError(500, fmt.Errorf("error getting paged transactions: %+v", err))
+		return
+	}
+
+	ptxs := make([]presenters.Tx, len(txs))
+	for i, tx := range txs {
+		ptxs[i] = presenters.NewTx(tx)
+	}
+	if buffer, err := NewPaginatedResponse(*c.Request.URL, size, page, count, ptxs); err != nil {
+		c.AbortWithError(500, err)
+	} else {
+		c.Data(200, MediaType, buffer)
+	}
+}
+
+// SpeedUp replaces an unconfirmed transaction with a new attempt at the
+// gas price in the body, which must be higher than its current one.
+// Example:
+//  "<application>/transactions/:TxHash/speed_up"
+func (tc *TransactionsController) SpeedUp(c *gin.Context) {
+	hash := common.HexToHash(c.Param("TxHash"))
+	if gasPrice, err := replacementGasPrice(c); err != nil {
+		c.AbortWithError(422, err)
+	} else if gasPrice == nil {
+		c.AbortWithError(422, errors.New("gasPrice is required"))
+	} else if tx, err := services.SpeedUpTx(hash, gasPrice, tc.App.GetStore()); err != nil {
+		abortReplaceTx(c, err)
+	} else {
+		respondWithTx(c, tx)
+	}
+}
+
+// Cancel replaces an unconfirmed transaction with a zero value transaction
+// to its sender, at the gas price in the body or a bumped one if omitted,
+// and cancels the runs waiting for it.
+// Example:
+//  "<application>/transactions/:TxHash/cancel"
+func (tc *TransactionsController) Cancel(c *gin.Context) {
+	hash := common.HexToHash(c.Param("TxHash"))
+	if gasPrice, err := replacementGasPrice(c); err != nil {
+		c.AbortWithError(422, err)
+	} else if tx, err := services.CancelTx(hash, gasPrice, tc.App.GetStore()); err != nil {
+		abortReplaceTx(c, err)
+	} else {
+		respondWithTx(c, tx)
+	}
+}
+
+func replacementGasPrice(c *gin.Context) (*big.Int, error) {
+	var request models.ReplaceTxRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&request); err != nil {
+			return nil, err
+		}
+	}
+	if request.GasPrice == nil {
+		return nil, nil
+	}
+	return request.GasPrice.ToBig(), nil
+}
+
+func abortReplaceTx(c *gin.Context, err error) {
+	if _, ok := err.(*store.ReplacementUnderpricedError); ok {
+		c.AbortWithError(422, err)
+	} else if err == orm.ErrorNotFound {
+		c.AbortWithError(404, errors.New("Transaction not found"))
+	} else if err == store.ErrTxConfirmed {
+		c.AbortWithError(409, err)
+	} else {
+		c.AbortWithError(500, err)
+	}
+}
+
+func respondWithTx(c *gin.Context, tx *models.Tx) {
+	if doc, err := jsonapi.Marshal(presenters.NewTx(*tx)); err != nil {
+		c.AbortWithError(500, err)
+	} else {
+		c.Data(200, MediaType, doc)
+	}
+}
@@ -0,0 +1,171 @@
+package web_test
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/manyminds/api2go/jsonapi"
+	"github.com/smartcontractkit/chainlink/internal/cltest"
+	"github.com/smartcontractkit/chainlink/store/models"
+	"github.com/smartcontractkit/chainlink/store/presenters"
+	"github.com/smartcontractkit/chainlink/utils"
+	"github.com/smartcontractkit/chainlink/web"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransactionsController_Index(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplicationWithKeyStore()
+	defer cleanup()
+	ethMock := app.MockEthClient()
+	ethMock.Register("eth_getTransactionCount", "0x100")
+	require.NoError(t, app.Start())
+
+	store := app.GetStore()
+	client := app.NewHTTPClient()
+
+	from := cltest.GetAccountAddress(store)
+	unconfirmed := cltest.CreateTxAndAttempt(store, from, 1)
+	confirmed := cltest.CreateTxAndAttempt(store, from, 2)
+	attempts, err := store.TxAttemptsFor(confirmed.ID)
+	require.NoError(t, err)
+	require.NoError(t, store.ConfirmTx(confirmed, &attempts[0]))
+	other := cltest.CreateTxAndAttempt(store, cltest.NewAddress(), 3)
+
+	tests := []struct {
+		name    string
+		query   string
+		wantIDs []uint64
+	}{
+		{"all", "", []uint64{other.ID, confirmed.ID, unconfirmed.ID}},
+		{"unconfirmed", "?status=unconfirmed&account=" + from.Hex(), []uint64{unconfirmed.ID}},
+		{"confirmed", "?status=confirmed", []uint64{confirmed.ID}},
+		{"account", "?account=" + from.Hex(), []uint64{confirmed.ID, unconfirmed.ID}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resp, cleanup := client.Get("/v2/transactions" + test.query)
+			defer cleanup()
+			cltest.AssertServerResponse(t, resp, 200)
+
+			var links jsonapi.Links
+			var txs []presenters.Tx
+			err := web.ParsePaginatedResponse(cltest.ParseResponseBody(resp), &txs, &links)
+			require.NoError(t, err)
+
+			ids := []uint64{}
+			for _, tx := range txs {
+				ids = append(ids, tx.ID)
+			}
+			assert.Equal(t, test.wantIDs, ids)
+		})
+	}
+
+	resp, cleanup := client.Get("/v2/transactions?status=pending")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, 422)
+
+	resp, cleanup = client.Get("/v2/transactions?account=0xnope")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, 422)
+}
+
+func TestTransactionsController_SpeedUp(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplicationWithKeyStore()
+	defer cleanup()
+	ethMock := app.MockEthClient()
+	ethMock.Context("app.StartAndConnect()", func(ethMock *cltest.EthMock) {
+		ethMock.Register("eth_getTransactionCount", utils.Uint64ToHex(0))
+	})
+	require.NoError(t, app.StartAndConnect())
+
+	store := app.GetStore()
+	client := app.NewHTTPClient()
+	tx := cltest.CreateTxAndAttempt(store, cltest.GetAccountAddress(store), 1)
+
+	resp, cleanup := client.Post("/v2/transactions/"+tx.Hash.Hex()+"/speed_up", bytes.NewBufferString(`{}`))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, 422)
+
+	resp, cleanup = client.Post("/v2/transactions/"+tx.Hash.Hex()+"/speed_up", bytes.NewBufferString(`{"gasPrice":"1"}`))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, 422)
+
+	resp, cleanup = client.Post("/v2/transactions/"+cltest.NewHash().Hex()+"/speed_up", bytes.NewBufferString(`{"gasPrice":"50"}`))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, 404)
+
+	ethMock.Register("eth_blockNumber", utils.Uint64ToHex(2))
+	ethMock.Register("eth_sendRawTransaction", cltest.NewHash())
+
+	resp, cleanup = client.Post("/v2/transactions/"+tx.Hash.Hex()+"/speed_up", bytes.NewBufferString(`{"gasPrice":"50"}`))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, 200)
+
+	var ptx presenters.Tx
+	require.NoError(t, cltest.ParseJSONAPIResponse(resp, &ptx))
+	assert.Equal(t, tx.ID, ptx.ID)
+	assert.Equal(t, big.NewInt(50), ptx.GasPrice)
+	assert.NotEqual(t, tx.Hash, ptx.Hash)
+	ethMock.EventuallyAllCalled(t)
+}
+
+func TestTransactionsController_Cancel(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplicationWithKeyStore()
+	defer cleanup()
+	ethMock := app.MockEthClient()
+	ethMock.Context("app.StartAndConnect()", func(ethMock *cltest.EthMock) {
+		ethMock.Register("eth_getTransactionCount", utils.Uint64ToHex(0))
+	})
+	require.NoError(t, app.StartAndConnect())
+
+	store := app.GetStore()
+	client := app.NewHTTPClient()
+	from := cltest.GetAccountAddress(store)
+	tx := cltest.CreateTxAndAttempt(store, from, 1)
+
+	j, initr := cltest.NewJobWithWebInitiator()
+	j.Tasks = []models.TaskSpec{cltest.NewTask("ethtx")}
+	require.NoError(t, store.SaveJob(&j))
+	jr := j.NewRun(initr)
+	result := models.RunResult{}.WithValue(tx.Hash.Hex()).MarkPendingConfirmations()
+	jr.TaskRuns[0] = jr.TaskRuns[0].ApplyResult(result)
+	jr = jr.ApplyResult(result)
+	require.NoError(t, store.SaveJobRun(&jr))
+
+	ethMock.Register("eth_blockNumber", utils.Uint64ToHex(2))
+	ethMock.Register("eth_sendRawTransaction", cltest.NewHash())
+
+	resp, cleanup := client.Post("/v2/transactions/"+tx.Hash.Hex()+"/cancel", nil)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, 200)
+
+	var ptx presenters.Tx
+	require.NoError(t, cltest.ParseJSONAPIResponse(resp, &ptx))
+	assert.Equal(t, from, ptx.To)
+	assert.Equal(t, int64(0), ptx.Value.Int64())
+	ethMock.EventuallyAllCalled(t)
+
+	jr, err := store.FindJobRun(jr.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.RunStatusPendingConfirmations, jr.Status, "runs are cancelled once the cancellation is confirmed")
+	require.Len(t, jr.Notes, 1)
+	assert.Contains(t, jr.Notes[0].Text, ptx.Hash.Hex())
+	assert.Contains(t, jr.Notes[0].Text, "may still be confirmed")
+
+	attempts, err := store.TxAttemptsFor(tx.ID)
+	require.NoError(t, err)
+	require.NoError(t, store.ConfirmTx(tx, &attempts[0]))
+
+	resp, cleanup = client.Post("/v2/transactions/"+tx.Hash.Hex()+"/cancel", nil)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, 409)
+}
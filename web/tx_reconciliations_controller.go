@@ -0,0 +1,28 @@
+package web
+
+import (
+	"fmt"
+
+	"github.com/gin-gonic/gin"
+	"github.com/manyminds/api2go/jsonapi"
+	"github.com/smartcontractkit/chainlink/services"
+)
+
+// TxReconciliationsController exposes the actions taken to reconcile the
+// node's transactions with the chain.
+type TxReconciliationsController struct {
+	App services.Application
+}
+
+// Index returns the most recent actions taken by the transaction reconciler,
+// newest first.
+// Example:
+//  "<application>/tx_reconciliations"
+func (trc *TxReconciliationsController) Index(c *gin.Context) {
+	reconciliations := trc.App.GetStore().TxReconciler.Reconciliations()
+	if json, err := jsonapi.Marshal(reconciliations); err != nil {
+		c.AbortWithError(500, fmt.Errorf("failed to marshal tx reconciliations using jsonapi: %+v", err))
+	} else {
+		c.Data(200, MediaType, json)
+	}
+}
@@ -0,0 +1,44 @@
+package web_test
+
+import (
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/internal/cltest"
+	"github.com/smartcontractkit/chainlink/store"
+	"github.com/smartcontractkit/chainlink/store/models"
+	"github.com/smartcontractkit/chainlink/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTxReconciliationsController_Index(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplicationWithKeyStore()
+	defer cleanup()
+	ethMock := app.MockEthClient()
+	ethMock.Context("app.Start()", func(ethMock *cltest.EthMock) {
+		ethMock.Register("eth_getTransactionCount", utils.Uint64ToHex(0))
+	})
+	require.NoError(t, app.StartAndConnect())
+	client := app.NewHTTPClient()
+
+	tx := cltest.CreateTxAndAttempt(app.Store, cltest.GetAccountAddress(app.Store), 1)
+	ethMock.Register("eth_blockNumber", utils.Uint64ToHex(2))
+	ethMock.Register("eth_getTransactionCount", utils.Uint64ToHex(0))
+	ethMock.Register("eth_getTransactionReceipt", store.TxReceipt{})
+	ethMock.Register("eth_sendRawTransaction", tx.Hash)
+	_, err := app.Store.TxReconciler.Reconcile()
+	require.NoError(t, err)
+
+	resp, cleanup := client.Get("/v2/tx_reconciliations")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, 200)
+
+	var reconciliations []models.TxReconciliation
+	require.NoError(t, cltest.ParseJSONAPIResponse(resp, &reconciliations))
+	require.Len(t, reconciliations, 1)
+	assert.Equal(t, models.TxReconcileRebroadcast, reconciliations[0].Action)
+	assert.Equal(t, tx.ID, reconciliations[0].TxID)
+	assert.Equal(t, &tx.Hash, reconciliations[0].TxHash)
+}